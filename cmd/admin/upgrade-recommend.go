@@ -20,10 +20,10 @@ import (
 	"os"
 	"sort"
 	"strings"
-	"text/tabwriter"
 
 	"github.com/blang/semver"
 	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/pkg/tableprinter"
 	"github.com/gmeghnag/omc/vars"
 	configv1 "github.com/openshift/api/config/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -45,7 +45,8 @@ func admUpgradeRecommendCommand(currentContextPath string) error {
 			os.Exit(1)
 		} 
 	}
-	if cv.Spec.Channel != "" {
+	delimited := tableprinter.IsDelimited(output)
+	if cv.Spec.Channel != "" && !delimited {
 		if cv.Spec.Upstream == "" {
 			fmt.Fprint(os.Stdout, "Upstream is unset, so the cluster will use an appropriate default.\n")
 		} else {
@@ -116,7 +117,7 @@ func admUpgradeRecommendCommand(currentContextPath string) error {
 	//
 	
 
-	if len(majorMinorBuckets) == 0 {
+	if len(majorMinorBuckets) == 0 && !delimited {
 		fmt.Fprintf(os.Stdout, "No updates available. You may still upgrade to a specific release image with --to-image or wait for new updates to be available.\n")
 		return nil
 	}
@@ -128,6 +129,8 @@ func admUpgradeRecommendCommand(currentContextPath string) error {
 	sort.Slice(majors, func(i, j int) bool {
 		return majors[i] > majors[j] // sort descending, major updates bring lots of features (enough to justify breaking backwards compatibility)
 	})
+	// delimited output lists every update, without the prose nor the outdated releases folding
+	updates := tableprinter.New(tableprinter.Options{Output: output}, "VERSION", "IMAGE", "ISSUES")
 	for _, major := range majors {
 		minors := make([]uint64, 0, len(majorMinorBuckets[major]))
 		for minor := range majorMinorBuckets[major] {
@@ -137,14 +140,27 @@ func admUpgradeRecommendCommand(currentContextPath string) error {
 			return minors[i] > minors[j] // sort descending, minor updates bring both feature and bugfixes
 		})
 		for _, minor := range minors {
+			if delimited {
+				sortConditionalUpdatesBySemanticVersions(majorMinorBuckets[major][minor])
+				for _, update := range majorMinorBuckets[major][minor] {
+					issues := noKnownIssues
+					if c := notRecommendedCondition(update); c != nil {
+						issues = c.Reason
+					}
+					updates.Append(update.Release.Version, update.Release.Image, issues)
+				}
+				continue
+			}
 			fmt.Fprintln(os.Stdout)
 			fmt.Fprintf(os.Stdout, "Updates to %d.%d:\n", major, minor)
 			lastWasLong := false
 			headerQueued := true
 
 			// set the minimal cell width to 14 to have a larger space between the columns for shorter versions
-			w := tabwriter.NewWriter(os.Stdout, 14, 2, 1, ' ', tabwriter.DiscardEmptyColumns)
-			fmt.Fprintf(w, "  VERSION\tISSUES\n")
+			newTable := func(noHeaders bool) *tableprinter.Table {
+				return tableprinter.New(tableprinter.Options{Indent: "  ", MinWidth: 14, NoHeaders: noHeaders}, "VERSION", "ISSUES")
+			}
+			w := newTable(false)
 			// TODO: add metadata about version
 
 			sortConditionalUpdatesBySemanticVersions(majorMinorBuckets[major][minor])
@@ -153,7 +169,7 @@ func admUpgradeRecommendCommand(currentContextPath string) error {
 				if lastWasLong || (c != nil && !showOutdatedReleases) {
 					fmt.Fprintln(os.Stdout)
 					if c == nil && !headerQueued {
-						fmt.Fprintf(w, "  VERSION\tISSUES\n")
+						w = newTable(false)
 						headerQueued = true
 					}
 					lastWasLong = false
@@ -164,13 +180,14 @@ func admUpgradeRecommendCommand(currentContextPath string) error {
 					break
 				}
 				if c == nil {
-					fmt.Fprintf(w, "  %s\t%s\n", update.Release.Version, "no known issues relevant to this cluster")
+					w.Append(update.Release.Version, noKnownIssues)
 					if !showOutdatedReleases {
 						headerQueued = false
-						w.Flush()
+						printTable(w)
+						w = newTable(true)
 					}
 				} else if showOutdatedReleases {
-					fmt.Fprintf(w, "  %s\t%s\n", update.Release.Version, c.Reason)
+					w.Append(update.Release.Version, c.Reason)
 				} else {
 					fmt.Fprintf(os.Stdout, "  Version: %s\n  Image: %s\n", update.Release.Version, update.Release.Image)
 					fmt.Fprintf(os.Stdout, "  Reason: %s\n  Message: %s\n", c.Reason, strings.ReplaceAll(strings.TrimSpace(c.Message), "\n", "\n  "))
@@ -178,10 +195,13 @@ func admUpgradeRecommendCommand(currentContextPath string) error {
 				}
 			}
 			if showOutdatedReleases {
-				w.Flush()
+				printTable(w)
 			}
 		}
 	}
+	if delimited {
+		printTable(updates)
+	}
 	return nil
 }
 
@@ -20,10 +20,10 @@ import (
 	"os"
 	"sort"
 	"strings"
-	"text/tabwriter"
 
 	"github.com/blang/semver"
 	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/pkg/tableprinter"
 	"github.com/gmeghnag/omc/vars"
 	configv1 "github.com/openshift/api/config/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,7 +33,9 @@ import (
 )
 
 var IncludeNotRecommended bool
+var output string
 
+const noKnownIssues = "no known issues relevant to this cluster"
 
 func admUpgradeCommand(currentContextPath string) {
 	cv := configv1.ClusterVersion{}
@@ -46,6 +48,23 @@ func admUpgradeCommand(currentContextPath string) {
 			os.Exit(1)
 		} 
 	}
+	if tableprinter.IsDelimited(output) {
+		table := tableprinter.New(tableprinter.Options{Output: output}, "VERSION", "IMAGE", "ISSUES")
+		sortReleasesBySemanticVersions(cv.Status.AvailableUpdates)
+		for _, update := range cv.Status.AvailableUpdates {
+			table.Append(update.Version, update.Image, noKnownIssues)
+		}
+		if IncludeNotRecommended {
+			sortConditionalUpdatesBySemanticVersions(cv.Status.ConditionalUpdates)
+			for _, update := range cv.Status.ConditionalUpdates {
+				if c := findCondition(update.Conditions, "Recommended"); c != nil && c.Status != metav1.ConditionTrue {
+					table.Append(update.Release.Version, update.Release.Image, c.Reason)
+				}
+			}
+		}
+		printTable(table)
+		return
+	}
 	if cv.Spec.Channel != "" {
 		if cv.Spec.Upstream == "" {
 			fmt.Fprint(os.Stdout, "Upstream is unset, so the cluster will use an appropriate default.\n")
@@ -61,14 +80,13 @@ func admUpgradeCommand(currentContextPath string) {
 	if len(cv.Status.AvailableUpdates) > 0 {
 		fmt.Fprintf(os.Stdout, "\nRecommended updates:\n\n")
 			// set the minimal cell width to 14 to have a larger space between the columns for shorter versions
-		w := tabwriter.NewWriter(os.Stdout, 14, 2, 1, ' ', 0)
-		fmt.Fprintf(w, "  VERSION\tIMAGE\n")
+		table := tableprinter.New(tableprinter.Options{Indent: "  ", MinWidth: 14}, "VERSION", "IMAGE")
 			// TODO: add metadata about version
 		sortReleasesBySemanticVersions(cv.Status.AvailableUpdates)
 		for _, update := range cv.Status.AvailableUpdates {
-			fmt.Fprintf(w, "  %s\t%s\n", update.Version, update.Image)
+			table.Append(update.Version, update.Image)
 		}
-		w.Flush()
+		printTable(table)
 		if c := findClusterOperatorStatusCondition(cv.Status.Conditions, configv1.RetrievedUpdates); c != nil && c.Status == configv1.ConditionFalse {
 			fmt.Fprintf(os.Stderr, "warning: Cannot refresh available updates:\n  Reason: %s\n  Message: %s\n\n", c.Reason, strings.ReplaceAll(c.Message, "\n", "\n  "))
 		}
@@ -117,10 +135,19 @@ func init() {
 		UpgradeRecommend,
 	)
 	Upgrade.PersistentFlags().BoolVar(&IncludeNotRecommended, "include-not-recommended", false, "Display additional updates which are not recommended based on your cluster configuration.")
+	Upgrade.PersistentFlags().StringVarP(&output, "output", "o", "", "Output format. One of: csv|tsv, printing the version, image and known issues of each update.")
 }
 
 
 // sortConditionalUpdatesBySemanticVersions sorts the input slice in decreasing order.
+// printTable prints a table of updates, exiting on error.
+func printTable(table *tableprinter.Table) {
+	if err := table.Print(os.Stdout); err != nil {
+		fmt.Println("error:", err)
+		os.Exit(1)
+	}
+}
+
 func sortConditionalUpdatesBySemanticVersions(updates []configv1.ConditionalUpdate) {
 	sort.Slice(updates, func(i, j int) bool {
 		a, errA := semver.Parse(updates[i].Release.Version)
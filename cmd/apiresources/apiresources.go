@@ -0,0 +1,206 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apiresources
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gmeghnag/omc/pkg/mustgather"
+	"github.com/gmeghnag/omc/pkg/tableprinter"
+	"github.com/gmeghnag/omc/vars"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+// options are the flags of "omc api-resources".
+type options struct {
+	output string
+	// apiGroup and namespaced filter the resources by group and by scope, unless nil
+	apiGroup   *string
+	namespaced *bool
+	noHeaders  bool
+	noCount    bool
+}
+
+var (
+	opts       options
+	apiGroup   string
+	namespaced bool
+)
+
+var APIResourcesCmd = &cobra.Command{
+	Use:   "api-resources",
+	Short: "Print the supported API resources of the must-gather.",
+	Long: `Print the resources omc can resolve in the must-gather in use: the built-in ones and the
+ones defined by the CRDs of the must-gather or of ~/.omc/customresourcedefinitions, along
+with the number of their objects stored in the must-gather. The objects are counted from the
+index built by "omc use --index" when there is one, and read otherwise, which --no-count skips.`,
+	Example: `  omc api-resources
+  omc api-resources -o wide
+  omc api-resources --namespaced=false
+  omc api-resources --no-count
+  omc api-resources --api-group=machineconfiguration.openshift.io -o name`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if vars.MustGatherRootPath == "" {
+			return fmt.Errorf("there are no must-gather resources defined, use \"omc use\" first")
+		}
+		if opts.output != "" && opts.output != "wide" && opts.output != "name" && !tableprinter.IsDelimited(opts.output) {
+			return fmt.Errorf("--output %s is not available, use one of: wide|name|csv|tsv", opts.output)
+		}
+		o := opts
+		if cmd.Flags().Changed("api-group") {
+			o.apiGroup = &apiGroup
+		}
+		if cmd.Flags().Changed("namespaced") {
+			o.namespaced = &namespaced
+		}
+		reader := mustgather.NewReader(vars.MustGatherRootPath, mustgather.WithParallelism(vars.Parallelism))
+		return printAPIResources(os.Stdout, reader, o)
+	},
+}
+
+var APIVersionsCmd = &cobra.Command{
+	Use:          "api-versions",
+	Short:        "Print the supported API versions of the must-gather, in the form of \"group/version\".",
+	Example:      `  omc api-versions`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if vars.MustGatherRootPath == "" {
+			return fmt.Errorf("there are no must-gather resources defined, use \"omc use\" first")
+		}
+		return printAPIVersions(os.Stdout, mustgather.NewReader(vars.MustGatherRootPath))
+	},
+}
+
+func init() {
+	APIResourcesCmd.Flags().StringVarP(&opts.output, "output", "o", "", "Output format. One of: wide|name|csv|tsv.")
+	APIResourcesCmd.Flags().StringVar(&apiGroup, "api-group", "", "Limit to resources in the specified API group, the core group if empty.")
+	APIResourcesCmd.Flags().BoolVar(&namespaced, "namespaced", true, "If false, non-namespaced resources will be returned, otherwise returning namespaced resources by default.")
+	APIResourcesCmd.Flags().BoolVar(&opts.noHeaders, "no-headers", false, "When using the default, wide, csv or tsv output format, don't print headers.")
+	APIResourcesCmd.Flags().BoolVar(&opts.noCount, "no-count", false, "If present, don't count the objects of the resources, omitting the OBJECTS column.")
+}
+
+// printAPIResources prints the resources of the catalog of the must-gather, with the number of
+// their stored objects unless only their names are printed or they are not counted.
+func printAPIResources(w io.Writer, reader *mustgather.Reader, o options) error {
+	var resources []metav1.APIResource
+	for _, r := range NewCatalog(reader).Preferred() {
+		if o.apiGroup != nil && r.Group != *o.apiGroup {
+			continue
+		}
+		if o.namespaced != nil && r.Namespaced != *o.namespaced {
+			continue
+		}
+		resources = append(resources, r)
+	}
+	if o.output == "name" {
+		for _, r := range resources {
+			name := r.Name
+			if r.Group != "" {
+				name += "." + r.Group
+			}
+			fmt.Fprintln(w, name)
+		}
+		return nil
+	}
+	var counts map[schema.GroupResource]int
+	headers := []string{"NAME", "SHORTNAMES", "APIVERSION", "NAMESPACED", "KIND"}
+	if !o.noCount {
+		var err error
+		if counts, err = countObjects(reader); err != nil {
+			return err
+		}
+		headers = append(headers, "OBJECTS")
+	}
+	// csv and tsv print the wide columns as well
+	wide := o.output == "wide" || tableprinter.IsDelimited(o.output)
+	if wide {
+		headers = append(headers, "VERBS", "CATEGORIES")
+	}
+	table := tableprinter.New(tableprinter.Options{Output: o.output, NoHeaders: o.noHeaders}, headers...)
+	for _, r := range resources {
+		gv := schema.GroupVersion{Group: r.Group, Version: r.Version}
+		row := []string{r.Name, strings.Join(r.ShortNames, ","), gv.String(), strconv.FormatBool(r.Namespaced), r.Kind}
+		if !o.noCount {
+			row = append(row, strconv.Itoa(counts[schema.GroupResource{Group: r.Group, Resource: r.Name}]))
+		}
+		if wide {
+			row = append(row, "["+strings.Join(r.Verbs, " ")+"]", strings.Join(r.Categories, ","))
+		}
+		table.Append(row...)
+	}
+	return table.Print(w)
+}
+
+// countObjects returns the number of objects of each resource stored in the must-gather.
+func countObjects(reader *mustgather.Reader) (map[schema.GroupResource]int, error) {
+	counts := make(map[schema.GroupResource]int)
+	namespaces, err := reader.Namespaces()
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if len(namespaces) > 0 {
+		counts[schema.GroupResource{Resource: "namespaces"}] = len(namespaces)
+	}
+	// only the resources stored in the must-gather are read
+	stored := make(map[schema.GroupResource]struct{})
+	for _, namespace := range append([]string{""}, namespaces...) {
+		resources, err := reader.Resources(namespace)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		for _, gr := range resources {
+			stored[gr] = struct{}{}
+		}
+	}
+	for gr := range stored {
+		count, err := reader.Count(gr.WithVersion(""))
+		if err != nil {
+			klog.V(1).ErrorS(err, "Unable to count the objects", "resource", gr.String())
+			continue
+		}
+		counts[gr] = count
+	}
+	return counts, nil
+}
+
+// printAPIVersions prints the group versions of the catalog of the must-gather, sorted.
+func printAPIVersions(w io.Writer, reader *mustgather.Reader) error {
+	versions := []string{"v1"}
+	for _, g := range NewCatalog(reader).Groups {
+		for _, v := range g.Versions {
+			versions = append(versions, v.GroupVersion)
+		}
+	}
+	sort.Strings(versions)
+	for _, v := range versions {
+		if _, err := fmt.Fprintln(w, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
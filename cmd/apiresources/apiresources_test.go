@@ -0,0 +1,268 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package apiresources
+
+import (
+	"bytes"
+	"encoding/gob"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gmeghnag/omc/internal/testutil"
+	"github.com/gmeghnag/omc/pkg/index"
+	"github.com/gmeghnag/omc/pkg/mustgather"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func newFixture(t *testing.T) *mustgather.Reader {
+	return mustgather.NewReader(newFixtureRoot(t))
+}
+
+// newFixtureRoot returns the root of a must-gather with CRDs and objects of several scopes.
+func newFixtureRoot(t *testing.T) string {
+	root := testutil.MustGather(t, map[string]string{
+		"namespaces/ns1/ns1.yaml":                     testutil.Namespace("ns1"),
+		"namespaces/ns2/ns2.yaml":                     testutil.Namespace("ns2"),
+		"namespaces/ns1/example.com/foos/f.yaml":      "apiVersion: example.com/v1\nkind: Foo\nmetadata:\n  name: f\n  namespace: ns1\n",
+		"namespaces/ns2/example.com/foos/f.yaml":      "apiVersion: example.com/v1\nkind: Foo\nmetadata:\n  name: f\n  namespace: ns2\n",
+		"namespaces/ns1/pods/p1/p1.yaml":              "apiVersion: v1\nkind: Pod\nmetadata:\n  name: p1\n  namespace: ns1\n",
+		"cluster-scoped-resources/core/nodes/n1.yaml": "apiVersion: v1\nkind: Node\nmetadata:\n  name: n1\n",
+		"cluster-scoped-resources/apiextensions.k8s.io/customresourcedefinitions/foos.example.com.yaml": `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: foos.example.com
+spec:
+  group: example.com
+  names:
+    kind: Foo
+    plural: foos
+    singular: foo
+    shortNames:
+    - fo
+    categories:
+    - all
+  scope: Namespaced
+  versions:
+  - name: v1beta1
+    served: true
+  - name: v1
+    served: true
+`,
+	})
+	// a CRD that is only stored locally
+	testutil.WriteFiles(t, os.Getenv("HOME"), map[string]string{
+		".omc/customresourcedefinitions/bars.example.com.yaml": `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: bars.example.com
+spec:
+  group: example.com
+  names:
+    kind: Bar
+    plural: bars
+    singular: bar
+  scope: Cluster
+  versions:
+  - name: v1alpha1
+    served: true
+`,
+	})
+	return root
+}
+
+func TestPrintAPIResources(t *testing.T) {
+	reader := newFixture(t)
+	clusterScoped := false
+	exampleGroup, coreGroup := "example.com", ""
+	tests := []struct {
+		name    string
+		opts    options
+		want    []string
+		notWant []string
+	}{
+		{
+			name: "default",
+			opts: options{},
+			want: []string{
+				"NAME SHORTNAMES APIVERSION NAMESPACED KIND OBJECTS",
+				"pods po v1 true Pod 1",
+				"nodes no v1 false Node 1",
+				"namespaces ns v1 false Namespace 2",
+				"configmaps cm v1 true ConfigMap 0",
+				"foos fo example.com/v1 true Foo 2",
+				"bars example.com/v1alpha1 false Bar 0",
+			},
+		},
+		{
+			name:    "wide",
+			opts:    options{output: "wide", apiGroup: &exampleGroup, noHeaders: true},
+			want:    []string{"foos fo example.com/v1 true Foo 2 [get list watch] all"},
+			notWant: []string{"NAME", "pods"},
+		},
+		{
+			name:    "cluster-scoped",
+			opts:    options{namespaced: &clusterScoped},
+			want:    []string{"nodes no v1 false Node 1", "bars example.com/v1alpha1 false Bar 0"},
+			notWant: []string{"pods", "foos"},
+		},
+		{
+			name:    "core group",
+			opts:    options{apiGroup: &coreGroup},
+			want:    []string{"pods po v1 true Pod 1"},
+			notWant: []string{"foos", "apps/v1"},
+		},
+		{
+			name:    "csv",
+			opts:    options{output: "csv", apiGroup: &exampleGroup},
+			want:    []string{"NAME,SHORTNAMES,APIVERSION,NAMESPACED,KIND,OBJECTS,VERBS,CATEGORIES", "foos,fo,example.com/v1,true,Foo,2,[get list watch],all"},
+			notWant: []string{"pods"},
+		},
+		{
+			name:    "no count",
+			opts:    options{noCount: true, apiGroup: &coreGroup},
+			want:    []string{"NAME SHORTNAMES APIVERSION NAMESPACED KIND", "pods po v1 true Pod"},
+			notWant: []string{"OBJECTS"},
+		},
+		{
+			name:    "names",
+			opts:    options{output: "name", apiGroup: &exampleGroup},
+			want:    []string{"bars.example.com", "foos.example.com"},
+			notWant: []string{"OBJECTS"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := printAPIResources(&out, reader, tc.opts); err != nil {
+				t.Fatal(err)
+			}
+			lines := make(map[string]bool)
+			for _, line := range strings.Split(out.String(), "\n") {
+				lines[strings.Join(strings.Fields(line), " ")] = true
+			}
+			for _, want := range tc.want {
+				if !lines[want] {
+					t.Errorf("expected line %q in:\n%s", want, out.String())
+				}
+			}
+			for _, notWant := range tc.notWant {
+				if strings.Contains(out.String(), notWant) {
+					t.Errorf("unexpected %q in:\n%s", notWant, out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestCountObjects_Index(t *testing.T) {
+	root := newFixtureRoot(t)
+	want, err := countObjects(mustgather.NewReader(root))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := index.Build(root); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { index.Remove(root) })
+	// the indexed objects and the yaml files are replaced by invalid ones of the same size,
+	// the counts are only right if no object is decoded
+	dir, err := index.Dir(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	corrupt(t, dir, ".gob", func(path string) {
+		if filepath.Base(path) == "manifest.gob" {
+			return
+		}
+		var shard struct {
+			Sources []struct {
+				Path    string
+				ModTime int64
+				Size    int64
+			}
+			Names   []string
+			Objects [][]byte
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		if err := gob.NewDecoder(f).Decode(&shard); err != nil {
+			t.Fatal(err)
+		}
+		for i := range shard.Objects {
+			shard.Objects[i] = []byte("{")
+		}
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(shard); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	})
+	corrupt(t, root, ".yaml", func(path string) {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, bytes.Repeat([]byte("{"), int(info.Size())), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, info.ModTime(), info.ModTime()); err != nil {
+			t.Fatal(err)
+		}
+	})
+	got, err := countObjects(mustgather.NewReader(root))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected the indexed counts %v to be %v", got, want)
+	}
+	if got[schema.GroupResource{Group: "example.com", Resource: "foos"}] != 2 || got[schema.GroupResource{Resource: "nodes"}] != 1 {
+		t.Errorf("expected 2 foos and 1 node, got %v", got)
+	}
+}
+
+// corrupt calls replace on the files with the given extension under dir.
+func corrupt(t *testing.T, dir string, ext string, replace func(path string)) {
+	t.Helper()
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && filepath.Ext(path) == ext {
+			replace(path)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPrintAPIVersions(t *testing.T) {
+	var out bytes.Buffer
+	if err := printAPIVersions(&out, newFixture(t)); err != nil {
+		t.Fatal(err)
+	}
+	versions := strings.Split(strings.TrimSpace(out.String()), "\n")
+	for _, want := range []string{"v1", "apps/v1", "example.com/v1", "example.com/v1alpha1", "example.com/v1beta1"} {
+		found := false
+		for _, v := range versions {
+			found = found || v == want
+		}
+		if !found {
+			t.Errorf("expected %q in %v", want, versions)
+		}
+	}
+	if !strings.HasPrefix(out.String(), "admissionregistration.k8s.io/v1\n") {
+		t.Errorf("expected the versions to be sorted, got %v", versions)
+	}
+}
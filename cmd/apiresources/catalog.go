@@ -0,0 +1,229 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apiresources
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/gmeghnag/omc/cmd/get"
+	"github.com/gmeghnag/omc/pkg/mustgather"
+	"github.com/gmeghnag/omc/vars"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/klog/v2"
+)
+
+// readOnlyVerbs are the verbs supported for every served resource.
+var readOnlyVerbs = metav1.Verbs{"get", "list", "watch"}
+
+// Catalog holds the resources omc can resolve, as advertised by the discovery endpoints of
+// "omc serve": the built-in ones of known-resources.yaml and the ones defined by the CRDs of the
+// must-gather or of ~/.omc/customresourcedefinitions.
+type Catalog struct {
+	// Groups are the API groups other than the core one, sorted by name
+	Groups []metav1.APIGroup
+	// Resources are the resources of each group version, sorted by name
+	Resources map[schema.GroupVersion][]metav1.APIResource
+	ByGVR     map[schema.GroupVersionResource]metav1.APIResource
+}
+
+// NewCatalog returns the catalog of the resources of the must-gather read by reader.
+func NewCatalog(reader *mustgather.Reader) *Catalog {
+	c := &Catalog{
+		Resources: make(map[schema.GroupVersion][]metav1.APIResource),
+		ByGVR:     make(map[schema.GroupVersionResource]metav1.APIResource),
+	}
+	for _, r := range knownResources() {
+		c.add(r)
+	}
+	for _, crd := range get.CustomResourceDefinitions(reader) {
+		// the CRD alias map is also used to print the additional printer columns of custom resources
+		vars.AliasToCrd[strings.ToLower(crd.Spec.Names.Kind)+"."+crd.Spec.Group] = apiextensionsv1.CustomResourceDefinition{Spec: crd.Spec}
+		for _, v := range crd.Spec.Versions {
+			if !v.Served {
+				continue
+			}
+			c.add(metav1.APIResource{
+				Name:         crd.Spec.Names.Plural,
+				SingularName: crd.Spec.Names.Singular,
+				Namespaced:   crd.Spec.Scope == apiextensionsv1.NamespaceScoped,
+				Group:        crd.Spec.Group,
+				Version:      v.Name,
+				Kind:         crd.Spec.Names.Kind,
+				Verbs:        readOnlyVerbs,
+				ShortNames:   crd.Spec.Names.ShortNames,
+				Categories:   crd.Spec.Names.Categories,
+			})
+		}
+	}
+	for gv := range c.Resources {
+		sort.Slice(c.Resources[gv], func(i, j int) bool { return c.Resources[gv][i].Name < c.Resources[gv][j].Name })
+	}
+	versions := make(map[string][]string)
+	for gv := range c.Resources {
+		if gv.Group != "" {
+			versions[gv.Group] = append(versions[gv.Group], gv.Version)
+		}
+	}
+	for group, groupVersions := range versions {
+		sort.Slice(groupVersions, func(i, j int) bool {
+			return version.CompareKubeAwareVersionStrings(groupVersions[i], groupVersions[j]) > 0
+		})
+		apiGroup := metav1.APIGroup{Name: group}
+		for _, v := range groupVersions {
+			apiGroup.Versions = append(apiGroup.Versions, metav1.GroupVersionForDiscovery{GroupVersion: group + "/" + v, Version: v})
+		}
+		apiGroup.PreferredVersion = apiGroup.Versions[0]
+		c.Groups = append(c.Groups, apiGroup)
+	}
+	sort.Slice(c.Groups, func(i, j int) bool { return c.Groups[i].Name < c.Groups[j].Name })
+	return c
+}
+
+// add adds a resource to the catalog, unless a resource with the same name is already served in its group version.
+func (c *Catalog) add(r metav1.APIResource) {
+	gvr := schema.GroupVersionResource{Group: r.Group, Version: r.Version, Resource: r.Name}
+	if _, ok := c.ByGVR[gvr]; ok {
+		return
+	}
+	c.ByGVR[gvr] = r
+	gv := gvr.GroupVersion()
+	c.Resources[gv] = append(c.Resources[gv], r)
+}
+
+// Group returns the API group of the given name.
+func (c *Catalog) Group(name string) (metav1.APIGroup, bool) {
+	for _, g := range c.Groups {
+		if g.Name == name {
+			return g, true
+		}
+	}
+	return metav1.APIGroup{}, false
+}
+
+// Preferred returns a single version of each resource, sorted by group and name: the preferred
+// version of its group, or the most recent version it is served in.
+func (c *Catalog) Preferred() []metav1.APIResource {
+	resources := append([]metav1.APIResource{}, c.Resources[schema.GroupVersion{Version: "v1"}]...)
+	for _, g := range c.Groups {
+		var groupResources []metav1.APIResource
+		seen := make(map[string]struct{})
+		// the versions of a group are sorted by preference
+		for _, v := range g.Versions {
+			for _, r := range c.Resources[schema.GroupVersion{Group: g.Name, Version: v.Version}] {
+				if _, ok := seen[r.Name]; !ok {
+					seen[r.Name] = struct{}{}
+					groupResources = append(groupResources, r)
+				}
+			}
+		}
+		sort.Slice(groupResources, func(i, j int) bool { return groupResources[i].Name < groupResources[j].Name })
+		resources = append(resources, groupResources...)
+	}
+	return resources
+}
+
+// knownResources returns the built-in resources of known-resources.yaml, whose kind and
+// version are looked up in the schemes known to omc and to the kubernetes clientset.
+func knownResources() []metav1.APIResource {
+	aliases := make([]string, 0, len(vars.KnownResources))
+	for alias := range vars.KnownResources {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	byKind := make(map[schema.GroupKind]*metav1.APIResource)
+	var kinds []schema.GroupKind
+	for _, alias := range aliases {
+		value := vars.KnownResources[alias]
+		plural, _ := value["plural"].(string)
+		singular, _ := value["name"].(string)
+		group, _ := value["group"].(string)
+		namespaced, _ := value["namespaced"].(bool)
+		if group == "core" {
+			group = ""
+		}
+		gvk, ok := schemeKind(group, singular)
+		if !ok {
+			klog.V(3).Info("INFO ", "Skipping resource without a known kind: ", plural, ".", group)
+			continue
+		}
+		if strings.HasSuffix(gvk.Kind, "Review") || gvk.Kind == "Binding" {
+			// reviews and bindings can only be created, they are never part of a must-gather
+			continue
+		}
+		gk := gvk.GroupKind()
+		r, ok := byKind[gk]
+		if !ok {
+			r = &metav1.APIResource{
+				Name:         plural,
+				SingularName: singular,
+				Namespaced:   namespaced,
+				Group:        group,
+				Version:      gvk.Version,
+				Kind:         gvk.Kind,
+				Verbs:        readOnlyVerbs,
+			}
+			byKind[gk] = r
+			kinds = append(kinds, gk)
+		}
+		if plural != singular && r.Name == r.SingularName {
+			// some resources are listed under their singular name too
+			r.Name = plural
+		}
+		if alias != plural && alias != singular && !strings.Contains(alias, ".") {
+			r.ShortNames = append(r.ShortNames, alias)
+		}
+	}
+	resources := make([]metav1.APIResource, 0, len(kinds))
+	for _, gk := range kinds {
+		r := byKind[gk]
+		var shortNames []string
+		for _, s := range r.ShortNames {
+			if s != r.Name {
+				shortNames = append(shortNames, s)
+			}
+		}
+		r.ShortNames = shortNames
+		resources = append(resources, *r)
+	}
+	return resources
+}
+
+// schemeKind returns the kind of a resource of the given group and singular name, in the
+// most recent version known to the schemes.
+func schemeKind(group string, singular string) (schema.GroupVersionKind, bool) {
+	var found schema.GroupVersionKind
+	for _, s := range []*runtime.Scheme{vars.Schema, scheme.Scheme} {
+		if s == nil {
+			continue
+		}
+		for gvk := range s.AllKnownTypes() {
+			if gvk.Group != group || gvk.Version == runtime.APIVersionInternal || strings.ToLower(gvk.Kind) != singular {
+				continue
+			}
+			if found.Kind == "" || version.CompareKubeAwareVersionStrings(gvk.Version, found.Version) > 0 {
+				found = gvk
+			}
+		}
+	}
+	return found, found.Kind != ""
+}
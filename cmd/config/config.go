@@ -39,6 +39,7 @@ func init() {
 	ConfigCmd.PersistentFlags().BoolVarP(&vars.UseLocalCRDs, "use-local-crds", "", false, "If set to true, omc will search for valid CRDs also in ~/.omc/customresourcedefinitions")
 	ConfigCmd.PersistentFlags().StringVarP(&vars.DiffCmd, "diff-command", "", "", "Set the binary tool to use to execute \"omc mc diff <machineConfig1> <machineConfig2>\"")
 	ConfigCmd.PersistentFlags().StringVarP(&vars.DefaultProject, "default-project", "", "", "Set the default context project \"omc config --default-project=<NS>\"")
+	ConfigCmd.PersistentFlags().IntVarP(&vars.Parallelism, "parallelism", "", 0, "Set the default number of namespaces read concurrently by \"omc get -A\", 0 uses the number of CPUs")
 
 }
 
@@ -50,6 +51,7 @@ func SetConfig() {
 	omcConfigJson.UseLocalCRDs = vars.UseLocalCRDs
 	omcConfigJson.DiffCmd = vars.DiffCmd
 	omcConfigJson.DefaultProject = vars.DefaultProject
+	omcConfigJson.Parallelism = vars.Parallelism
 	file, _ = json.MarshalIndent(omcConfigJson, "", " ")
 	_ = ioutil.WriteFile(home+"/.omc/omc.json", file, 0644)
 
@@ -17,6 +17,7 @@ type testCase struct {
 	useLocalCRDs   bool
 	diffCmd        string
 	defaultProject string
+	parallelism    int
 }
 
 func TestSetConfig(t *testing.T) {
@@ -27,6 +28,7 @@ func TestSetConfig(t *testing.T) {
 			useLocalCRDs:   true,
 			diffCmd:        "diff",
 			defaultProject: "project1",
+			parallelism:    8,
 		},
 		{
 			name:           "Test Case 2",
@@ -56,6 +58,7 @@ func TestSetConfig(t *testing.T) {
 			vars.UseLocalCRDs = tc.useLocalCRDs
 			vars.DiffCmd = tc.diffCmd
 			vars.DefaultProject = tc.defaultProject
+			vars.Parallelism = tc.parallelism
 
 			// Temporarily set the HOME environment variable to the temp directory
 			originalHome := os.Getenv("HOME")
@@ -96,5 +99,8 @@ func compareConfig(actualConfig types.Config, tc testCase) bool {
 	if actualConfig.DefaultProject != tc.defaultProject {
 		return false
 	}
+	if actualConfig.Parallelism != tc.parallelism {
+		return false
+	}
 	return true
 }
@@ -23,6 +23,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/gmeghnag/omc/pkg/index"
 	"github.com/gmeghnag/omc/types"
 	"github.com/gmeghnag/omc/vars"
 
@@ -52,6 +53,7 @@ func DeleteContext(path string, omcConfigFile string, idFlag string) {
 	contexts = omcConfigJson.Contexts
 	for _, c := range contexts {
 		if c.Id == idFlag || c.Path == path {
+			_ = index.Remove(c.Path)
 			continue
 		} else {
 			NewContexts = append(NewContexts, types.Context{Id: c.Id, Path: c.Path, Current: c.Current, Project: c.Project})
@@ -17,29 +17,177 @@ package describe
 
 import (
 	"fmt"
+	"io"
 	//"github.com/gmeghnag/omc/cmd/describe/apps"
 	"os"
+	"slices"
 	"strings"
 
-	"github.com/gmeghnag/omc/cmd/describe/core"
+	"github.com/gmeghnag/omc/cmd/describe/fakeclient"
+	"github.com/gmeghnag/omc/cmd/get"
+	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/pkg/mustgather"
+	"github.com/gmeghnag/omc/vars"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	desc "k8s.io/kubectl/pkg/describe"
 )
 
 // DescribeCmd represents the describe command
 var DescribeCmd = &cobra.Command{
-	Use: "describe",
-	Short: "Show details of a specific resource or group of resources",
-	Run: func(cmd *cobra.Command, args []string) {
+	Use:          "describe",
+	Short:        "Show details of a specific resource or group of resources",
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
 		if len(args) == 0 {
-			cmd.Help()
-			os.Exit(0)
+			return cmd.Help()
 		}
-		fmt.Fprintln(os.Stderr, "Invalid object type:", args[0])
-		os.Exit(1)
+		return describeResources(cmd.OutOrStdout(), vars.MustGatherRootPath, vars.Namespace, vars.AllNamespaceBoolVar, vars.LabelSelectorStringVar, strings.ToLower(args[0]), args[1:])
 	},
 }
 
+// describeResources describes the resources of the given type matching the label selector, or only the named ones if any.
+// Kinds known to kubectl are rendered by its own describers over a fake clientset built from
+// the must-gather, every other kind falls back to a generic describer.
+func describeResources(w io.Writer, root string, namespace string, allNamespaces bool, selector string, resourceType string, names []string) error {
+	if len(names) > 0 && selector != "" {
+		return fmt.Errorf("name cannot be provided when a selector is specified")
+	}
+	resourceNamePlural, resourceGroup, _, namespaced, err := get.KindGroupNamespaced(resourceType)
+	if err != nil {
+		return fmt.Errorf("the server doesn't have a resource type \"%s\"", resourceType)
+	}
+	reader := mustgather.NewReader(root, mustgather.WithParallelism(vars.Parallelism))
+	gvr := schema.GroupVersionResource{Group: resourceGroup, Resource: resourceNamePlural}
+	var items []unstructured.Unstructured
+	switch {
+	case resourceNamePlural == "namespaces" || resourceNamePlural == "projects":
+		for _, name := range names {
+			item, err := reader.Get(schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}, "", name)
+			if err != nil {
+				return fmt.Errorf("namespaces \"%s\" not found", name)
+			}
+			items = append(items, *item)
+		}
+		namespace = ""
+	case namespaced:
+		if allNamespaces {
+			namespace = ""
+		}
+		err = reader.VisitNamespaced(gvr, namespace, func(_ string, nsItems []unstructured.Unstructured) error {
+			items = append(items, nsItems...)
+			return nil
+		})
+	default:
+		items, err = reader.List(gvr, "", metav1.ListOptions{})
+		namespace = ""
+	}
+	if err != nil {
+		return err
+	}
+	if len(names) > 0 {
+		items, err = selectByName(items, names, resourceNamePlural, resourceGroup)
+		if err != nil {
+			return err
+		}
+	}
+	if selector != "" {
+		var matching []unstructured.Unstructured
+		for _, item := range items {
+			labelsOk, err := helpers.MatchLabelsFromMap(item.GetLabels(), selector)
+			if err != nil {
+				return fmt.Errorf("invalid label selector %q: %w", selector, err)
+			}
+			if labelsOk {
+				matching = append(matching, item)
+			}
+		}
+		items = matching
+	}
+	if len(items) == 0 {
+		if namespace != "" {
+			fmt.Fprintf(os.Stderr, "No resources found in %s namespace.\n", namespace)
+		} else {
+			fmt.Fprintln(os.Stderr, "No resources found")
+		}
+		return nil
+	}
+
+	var clientConfig *rest.Config
+	var outputs []string
+	for i := range items {
+		item := &items[i]
+		var out string
+		if hasDescriber(item.GroupVersionKind().GroupKind()) {
+			if clientConfig == nil {
+				clientConfig = fakeclient.RESTConfig(relatedClientset(root, items, resourceNamePlural, namespaced))
+			}
+			d, _ := desc.DescriberFor(item.GroupVersionKind().GroupKind(), clientConfig)
+			out, err = d.Describe(item.GetNamespace(), item.GetName(), desc.DescriberSettings{ShowEvents: true})
+		} else {
+			out, err = describeUnstructured(item)
+		}
+		if err != nil {
+			return fmt.Errorf("error describing %s %q: %w", item.GetKind(), item.GetName(), err)
+		}
+		outputs = append(outputs, out)
+	}
+	printSeparated(w, outputs)
+	return nil
+}
+
+// relatedClientset returns the fake clientset the kubectl describers look up the objects related
+// to the described items in. Only the namespaces of the items, or the described namespaces, are
+// loaded, and for the other cluster-scoped items the pods and events related to them.
+func relatedClientset(root string, items []unstructured.Unstructured, resourceNamePlural string, namespaced bool) *fake.Clientset {
+	if !namespaced && resourceNamePlural != "namespaces" && resourceNamePlural != "projects" {
+		return fakeclient.NewRelatedClientset(root, items)
+	}
+	var namespaces []string
+	for _, item := range items {
+		namespace := item.GetNamespace()
+		if !namespaced {
+			namespace = item.GetName()
+		}
+		if !slices.Contains(namespaces, namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	return fakeclient.NewClientset(root, namespaces)
+}
+
+// Describe writes the description of a single object of the given resource type to w, as "omc describe" does.
+func Describe(w io.Writer, root string, namespace string, resourceType string, name string) error {
+	return describeResources(w, root, namespace, false, "", resourceType, []string{name})
+}
+
+// selectByName returns the items matching the given names, in the order the names were given,
+// the same name may match items from several namespaces.
+func selectByName(items []unstructured.Unstructured, names []string, resourceNamePlural string, resourceGroup string) ([]unstructured.Unstructured, error) {
+	byName := make(map[string][]unstructured.Unstructured, len(items))
+	for _, item := range items {
+		byName[item.GetName()] = append(byName[item.GetName()], item)
+	}
+	resource := resourceNamePlural
+	if resourceGroup != "core" && resourceGroup != "" {
+		resource += "." + resourceGroup
+	}
+	var selected []unstructured.Unstructured
+	for _, name := range names {
+		matching, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("%s \"%s\" not found", resource, name)
+		}
+		selected = append(selected, matching...)
+	}
+	return selected, nil
+}
+
 func init() {
 	if len(os.Args) > 2 && os.Args[1] == "describe" {
 		if strings.Contains(os.Args[2], "/") {
@@ -48,9 +196,6 @@ func init() {
 			os.Args = append([]string{os.Args[0], "describe", resource, name}, os.Args[3:]...)
 		}
 	}
-	DescribeCmd.AddCommand(
-		//apps.Deployment,
-		core.Node,
-		core.Pod,
-	)
+	DescribeCmd.PersistentFlags().BoolVarP(&vars.AllNamespaceBoolVar, "all-namespaces", "A", false, "If present, list the requested object(s) across all namespaces.")
+	DescribeCmd.PersistentFlags().StringVarP(&vars.LabelSelectorStringVar, "selector", "l", "", "Selector (label query) to filter on, supports '=', '==', '!=', 'in', 'notin', 'key' and '!key'.(e.g. -l key1=value1,key2 in (value2,value3),!key3)")
 }
@@ -0,0 +1,503 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package describe
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gmeghnag/omc/internal/testutil"
+	"github.com/gmeghnag/omc/pkg/mustgather"
+	"github.com/gmeghnag/omc/vars"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func newDescribeFixture(t *testing.T) string {
+	root := testutil.MustGather(t, map[string]string{
+		"namespaces/ns1/apps/deployments.yaml": `apiVersion: v1
+kind: List
+items:
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: web
+    namespace: ns1
+    uid: d1
+  spec:
+    replicas: 1
+    selector:
+      matchLabels:
+        app: web
+    template:
+      metadata:
+        labels:
+          app: web
+      spec:
+        containers:
+        - name: web
+          image: nginx
+`,
+		"namespaces/ns1/apps/replicasets.yaml": `apiVersion: v1
+kind: List
+items:
+- apiVersion: apps/v1
+  kind: ReplicaSet
+  metadata:
+    name: web-5d4f8
+    namespace: ns1
+    labels:
+      app: web
+    ownerReferences:
+    - apiVersion: apps/v1
+      kind: Deployment
+      name: web
+      uid: d1
+      controller: true
+  spec:
+    replicas: 1
+    selector:
+      matchLabels:
+        app: web
+    template:
+      metadata:
+        labels:
+          app: web
+      spec:
+        containers:
+        - name: web
+          image: nginx
+`,
+		"namespaces/ns1/route.openshift.io/routes/web.yaml": `apiVersion: route.openshift.io/v1
+kind: Route
+metadata:
+  name: web
+  namespace: ns1
+spec:
+  host: web.example.com
+`,
+		"namespaces/ns1/core/pods.yaml": `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: web-5d4f8-x2x9z
+    namespace: ns1
+    uid: p1
+    labels:
+      app: web
+  spec:
+    nodeName: master-0
+    containers:
+    - name: web
+      image: nginx
+      resources:
+        requests:
+          cpu: 250m
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: web-5d4f8-k7q2m
+    namespace: ns1
+    uid: p3
+    labels:
+      app: web
+  spec:
+    containers:
+    - name: web
+      image: nginx
+`,
+		"namespaces/ns1/core/events.yaml": `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Event
+  metadata:
+    name: web-5d4f8-x2x9z.1
+    namespace: ns1
+  involvedObject:
+    kind: Pod
+    name: web-5d4f8-x2x9z
+    namespace: ns1
+    uid: p1
+  reason: Pulled
+  message: Successfully pulled image "nginx"
+  type: Normal
+- apiVersion: v1
+  kind: Event
+  metadata:
+    name: other.1
+    namespace: ns1
+  involvedObject:
+    kind: Pod
+    name: other
+    namespace: ns1
+    uid: p2
+  reason: BackOff
+  message: Back-off restarting failed container
+  type: Warning
+`,
+		"namespaces/ns2/core/pods.yaml": `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: db-0
+    namespace: ns2
+  spec:
+    nodeName: master-0
+    containers:
+    - name: db
+      image: postgres
+`,
+		"namespaces/default/core/events.yaml": `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Event
+  metadata:
+    name: master-0.1
+    namespace: default
+  involvedObject:
+    kind: Node
+    name: master-0
+    uid: master-0
+  reason: NodeReady
+  message: Node master-0 status is now NodeReady
+  type: Normal
+`,
+		"cluster-scoped-resources/core/nodes/master-0.yaml": `apiVersion: v1
+kind: Node
+metadata:
+  name: master-0
+  uid: master-0
+  labels:
+    node-role.kubernetes.io/master: ""
+status:
+  allocatable:
+    cpu: "4"
+    memory: 16Gi
+`,
+		"cluster-scoped-resources/core/nodes/worker-0.yaml": `apiVersion: v1
+kind: Node
+metadata:
+  name: worker-0
+  uid: worker-0
+  labels:
+    node-role.kubernetes.io/worker: ""
+`,
+	})
+	return root
+}
+
+func TestDescribeResources(t *testing.T) {
+	tests := []struct {
+		name          string
+		namespace     string
+		allNamespaces bool
+		selector      string
+		resourceType  string
+		names         []string
+		want          []string
+		notWant       []string
+		wantErr       string
+	}{
+		{
+			name:         "kubectl describer resolves related objects",
+			namespace:    "ns1",
+			resourceType: "deployment",
+			names:        []string{"web"},
+			want:         []string{"Name:               web", "NewReplicaSet:     web-5d4f8"},
+		},
+		{
+			name:         "generic describer for kinds without a kubectl describer",
+			namespace:    "ns1",
+			resourceType: "route",
+			want:         []string{"Name:         web", "Host:  web.example.com"},
+		},
+		{
+			name:         "events involving the object are shown",
+			namespace:    "ns1",
+			resourceType: "pod",
+			names:        []string{"web-5d4f8-x2x9z"},
+			want:         []string{"Events:", `Successfully pulled image "nginx"`},
+			notWant:      []string{"Back-off restarting failed container"},
+		},
+		{
+			name:         "label selector",
+			namespace:    "ns1",
+			selector:     "app=web",
+			resourceType: "replicasets",
+			want:         []string{"Name:           web-5d4f8\n"},
+		},
+		{
+			name:         "label selector matching nothing",
+			namespace:    "ns1",
+			selector:     "app=db",
+			resourceType: "replicasets",
+			notWant:      []string{"web-5d4f8"},
+		},
+		{
+			name:          "all namespaces",
+			namespace:     "default",
+			allNamespaces: true,
+			resourceType:  "pods",
+			want:          []string{"web-5d4f8-x2x9z", "Namespace:    ns2"},
+		},
+		{
+			name:         "unknown name",
+			namespace:    "ns1",
+			resourceType: "deployments",
+			names:        []string{"missing"},
+			wantErr:      `deployments.apps "missing" not found`,
+		},
+	}
+	root := newDescribeFixture(t)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			err := describeResources(&out, root, tc.namespace, tc.allNamespaces, tc.selector, tc.resourceType, tc.names)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+				}
+			}
+			for _, notWant := range tc.notWant {
+				if strings.Contains(out.String(), notWant) {
+					t.Errorf("expected output not to contain %q, got:\n%s", notWant, out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestRelatedClientset(t *testing.T) {
+	root := newDescribeFixture(t)
+	reader := mustgather.NewReader(root)
+	names := func(t *testing.T, list runtime.Object) []string {
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, item := range items {
+			object, _ := meta.Accessor(item)
+			names = append(names, object.GetNamespace()+"/"+object.GetName())
+		}
+		sort.Strings(names)
+		return names
+	}
+	tests := []struct {
+		name               string
+		resource           schema.GroupVersionResource
+		namespace          string
+		resourceNamePlural string
+		namespaced         bool
+		wantPods           []string
+		wantEvents         []string
+		wantReplicaSets    []string
+	}{
+		{
+			name:               "pods and events of a node",
+			resource:           schema.GroupVersionResource{Version: "v1", Resource: "nodes"},
+			resourceNamePlural: "nodes",
+			wantPods:           []string{"ns1/web-5d4f8-x2x9z", "ns2/db-0"},
+			wantEvents:         []string{"default/master-0.1"},
+		},
+		{
+			name:               "objects of the namespaces of the items",
+			resource:           schema.GroupVersionResource{Version: "v1", Resource: "pods"},
+			namespace:          "ns2",
+			resourceNamePlural: "pods",
+			namespaced:         true,
+			wantPods:           []string{"ns2/db-0"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			items, err := reader.List(tc.resource, tc.namespace, metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.resourceNamePlural == "nodes" {
+				items = items[:1]
+			}
+			cs := relatedClientset(root, items, tc.resourceNamePlural, tc.namespaced)
+			ctx := context.Background()
+			pods, err := cs.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			events, err := cs.CoreV1().Events("").List(ctx, metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			replicaSets, err := cs.AppsV1().ReplicaSets("").List(ctx, metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := names(t, pods); !slices.Equal(got, tc.wantPods) {
+				t.Errorf("expected the pods %v, got %v", tc.wantPods, got)
+			}
+			if got := names(t, events); !slices.Equal(got, tc.wantEvents) {
+				t.Errorf("expected the events %v, got %v", tc.wantEvents, got)
+			}
+			if got := names(t, replicaSets); !slices.Equal(got, tc.wantReplicaSets) {
+				t.Errorf("expected the replica sets %v, got %v", tc.wantReplicaSets, got)
+			}
+		})
+	}
+}
+
+func TestDescribeCmd(t *testing.T) {
+	root := newDescribeFixture(t)
+	// describe is run as a subcommand, as in omc describe
+	omc := &cobra.Command{Use: "omc"}
+	omc.AddCommand(DescribeCmd)
+	savedPath := vars.MustGatherRootPath
+	savedNs := vars.Namespace
+	savedAll := vars.AllNamespaceBoolVar
+	savedSelector := vars.LabelSelectorStringVar
+	t.Cleanup(func() {
+		vars.MustGatherRootPath = savedPath
+		vars.Namespace = savedNs
+		vars.AllNamespaceBoolVar = savedAll
+		vars.LabelSelectorStringVar = savedSelector
+		omc.RemoveCommand(DescribeCmd)
+	})
+	vars.MustGatherRootPath = root
+
+	tests := []struct {
+		name      string
+		args      []string
+		namespace string
+		want      []string
+		notWant   []string
+		// descriptions is the number of objects described
+		descriptions int
+		wantErr      string
+	}{
+		{
+			name:         "pod",
+			args:         []string{"pod", "web-5d4f8-x2x9z"},
+			namespace:    "ns1",
+			want:         []string{"web-5d4f8-x2x9z\nNamespace:", `Successfully pulled image "nginx"`},
+			descriptions: 1,
+		},
+		{
+			name:         "pods are separated",
+			args:         []string{"pods", "-l", "app=web"},
+			namespace:    "ns1",
+			want:         []string{"web-5d4f8-x2x9z\nNamespace:", "\n\n\nName:", "web-5d4f8-k7q2m\nNamespace:"},
+			descriptions: 2,
+		},
+		{
+			name:         "pods of all namespaces",
+			args:         []string{"po", "-A"},
+			namespace:    "ns1",
+			want:         []string{"db-0\nNamespace:"},
+			descriptions: 3,
+		},
+		{
+			name:      "unknown pod",
+			args:      []string{"pod", "missing"},
+			namespace: "ns1",
+			wantErr:   `pods "missing" not found`,
+		},
+		{
+			name: "node",
+			args: []string{"node", "master-0"},
+			want: []string{
+				"master-0\nRoles:",
+				"Non-terminated Pods:",
+				"ns1                         web-5d4f8-x2x9z    250m (6%)",
+				"ns2                         db-0",
+				"Allocated resources:",
+				"Node master-0 status is now NodeReady",
+			},
+			notWant:      []string{"web-5d4f8-k7q2m"},
+			descriptions: 1,
+		},
+		{
+			name:         "nodes are separated",
+			args:         []string{"nodes"},
+			want:         []string{"master-0\nRoles:", "\n\n\nName:", "worker-0\nRoles:"},
+			descriptions: 2,
+		},
+		{
+			name:         "node label selector",
+			args:         []string{"node", "-l", "node-role.kubernetes.io/worker"},
+			want:         []string{"worker-0\nRoles:"},
+			notWant:      []string{"master-0"},
+			descriptions: 1,
+		},
+		{
+			name:    "unknown node",
+			args:    []string{"node", "missing"},
+			wantErr: `nodes "missing" not found`,
+		},
+		{
+			name:    "node name and label selector",
+			args:    []string{"node", "worker-0", "-l", "node-role.kubernetes.io/worker"},
+			wantErr: "name cannot be provided when a selector is specified",
+		},
+		{
+			name:      "invalid selector",
+			args:      []string{"pods", "-l", "app in web"},
+			namespace: "ns1",
+			wantErr:   "invalid label selector",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vars.Namespace = tc.namespace
+			vars.AllNamespaceBoolVar = false
+			vars.LabelSelectorStringVar = ""
+			var out bytes.Buffer
+			omc.SetOut(&out)
+			omc.SetArgs(append([]string{"describe"}, tc.args...))
+			err := omc.Execute()
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("expected output to contain %q, got:\n%s", want, out.String())
+				}
+			}
+			for _, notWant := range tc.notWant {
+				if strings.Contains(out.String(), notWant) {
+					t.Errorf("expected output not to contain %q, got:\n%s", notWant, out.String())
+				}
+			}
+			if got := strings.Count("\n"+out.String(), "\nName:"); got != tc.descriptions {
+				t.Errorf("expected %d descriptions, got %d:\n%s", tc.descriptions, got, out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package describe
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+
+	"github.com/fatih/camelcase"
+	"github.com/gmeghnag/omc/cmd/helpers"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	desc "k8s.io/kubectl/pkg/describe"
+)
+
+// hasDescriber reports whether kubectl provides a dedicated describer for the given kind.
+func hasDescriber(kind schema.GroupKind) bool {
+	_, ok := desc.DescriberFor(kind, &rest.Config{})
+	return ok
+}
+
+// describeUnstructured is the fallback used for kinds without a dedicated describer,
+// such as openshift resources and custom resources, it renders the object the same way
+// kubectl's generic describer does.
+func describeUnstructured(obj *unstructured.Unstructured) (string, error) {
+	out := new(tabwriter.Writer)
+	buf := &bytes.Buffer{}
+	out.Init(buf, 0, 8, 2, ' ', 0)
+	w := desc.NewPrefixWriter(out)
+	w.Write(desc.LEVEL_0, "Name:\t%s\n", obj.GetName())
+	w.Write(desc.LEVEL_0, "Namespace:\t%s\n", obj.GetNamespace())
+	printMapMultiline(w, "Labels", obj.GetLabels())
+	printMapMultiline(w, "Annotations", obj.GetAnnotations())
+	printUnstructuredContent(w, desc.LEVEL_0, obj.UnstructuredContent(), "", ".metadata.managedFields", ".metadata.name",
+		".metadata.namespace", ".metadata.labels", ".metadata.annotations")
+	if err := out.Flush(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func printMapMultiline(w desc.PrefixWriter, title string, m map[string]string) {
+	w.Write(desc.LEVEL_0, "%s:\t", title)
+	if len(m) == 0 {
+		w.WriteLine("<none>")
+		return
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		if i != 0 {
+			w.Write(desc.LEVEL_0, "\t")
+		}
+		w.Write(desc.LEVEL_0, "%s=%s\n", k, m[k])
+	}
+}
+
+func printUnstructuredContent(w desc.PrefixWriter, level int, content map[string]interface{}, skipPrefix string, skip ...string) {
+	fields := make([]string, 0, len(content))
+	for field := range content {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		skipExpr := fmt.Sprintf("%s.%s", skipPrefix, field)
+		if helpers.StringInSlice(skipExpr, skip) {
+			continue
+		}
+		switch typedValue := content[field].(type) {
+		case map[string]interface{}:
+			w.Write(level, "%s:\n", smartLabelFor(field))
+			printUnstructuredContent(w, level+1, typedValue, skipExpr, skip...)
+		case []interface{}:
+			w.Write(level, "%s:\n", smartLabelFor(field))
+			for _, child := range typedValue {
+				switch typedChild := child.(type) {
+				case map[string]interface{}:
+					printUnstructuredContent(w, level+1, typedChild, skipExpr, skip...)
+				default:
+					w.Write(level+1, "%v\n", typedChild)
+				}
+			}
+		default:
+			w.Write(level, "%s:\t%v\n", smartLabelFor(field), typedValue)
+		}
+	}
+}
+
+// smartLabelFor turns a camelCase field name into a "Camel Case" label.
+func smartLabelFor(field string) string {
+	if strings.IndexFunc(field, func(r rune) bool {
+		return !unicode.IsLetter(r) && r != '-'
+	}) != -1 {
+		return field
+	}
+	commonAcronyms := []string{"API", "URL", "UID", "OSB", "GUID"}
+	parts := camelcase.Split(field)
+	result := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part == "_" {
+			continue
+		}
+		if helpers.StringInSlice(strings.ToUpper(part), commonAcronyms) {
+			part = strings.ToUpper(part)
+		} else {
+			part = strings.ToUpper(part[:1]) + part[1:]
+		}
+		result = append(result, part)
+	}
+	return strings.Join(result, " ")
+}
+
+func printSeparated(w io.Writer, outputs []string) {
+	for i, s := range outputs {
+		if i == 0 {
+			fmt.Fprint(w, s)
+		} else {
+			fmt.Fprintf(w, "\n\n%s", s)
+		}
+	}
+}
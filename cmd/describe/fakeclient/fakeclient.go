@@ -0,0 +1,253 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fakeclient
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/pkg/mustgather"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/klog/v2"
+)
+
+// NewClientset returns a fake clientset holding every object of the must-gather
+// rooted in root whose kind is known to the kubernetes clientset scheme, so that
+// kubectl describers can look up the objects related to the one being described.
+// Only the given namespaces are loaded, all of them if namespaces is empty;
+// cluster-scoped resources are always loaded.
+func NewClientset(root string, namespaces []string) *fake.Clientset {
+	cs := NewSimpleClientset()
+	groups := knownGroups()
+	reader := mustgather.NewReader(root)
+	if len(namespaces) == 0 {
+		entries, _ := os.ReadDir(filepath.Join(root, "namespaces"))
+		for _, e := range entries {
+			if e.IsDir() && !strings.HasPrefix(e.Name(), ".") {
+				namespaces = append(namespaces, e.Name())
+			}
+		}
+	}
+	for _, namespace := range namespaces {
+		if ns, err := reader.Get(schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}, "", namespace); err == nil {
+			addObjects(cs, []unstructured.Unstructured{*ns})
+		}
+		namespaceDir := filepath.Join(root, "namespaces", namespace)
+		for group, plurals := range resourcesInDir(namespaceDir, groups) {
+			if group == "core" {
+				if ok, _ := exists(filepath.Join(namespaceDir, "pods")); ok {
+					plurals["pods"] = struct{}{}
+				}
+			}
+			for plural := range plurals {
+				items, err := reader.List(schema.GroupVersionResource{Group: group, Resource: plural}, namespace, metav1.ListOptions{})
+				if err != nil {
+					klog.V(3).ErrorS(err, "Skipping resources", "namespace", namespace, "group", group, "resource", plural)
+					continue
+				}
+				addObjects(cs, items)
+			}
+		}
+	}
+	for group, plurals := range resourcesInDir(filepath.Join(root, "cluster-scoped-resources"), groups) {
+		for plural := range plurals {
+			items, err := reader.List(schema.GroupVersionResource{Group: group, Resource: plural}, "", metav1.ListOptions{})
+			if err != nil {
+				klog.V(3).ErrorS(err, "Skipping resources", "group", group, "resource", plural)
+				continue
+			}
+			addObjects(cs, items)
+		}
+	}
+	return cs
+}
+
+// NewRelatedClientset returns a fake clientset holding the given cluster-scoped objects of the
+// must-gather rooted in root and the objects kubectl describers look up for them: the pods
+// scheduled on the nodes and the events involving the objects, of every namespace.
+func NewRelatedClientset(root string, items []unstructured.Unstructured) *fake.Clientset {
+	cs := NewSimpleClientset()
+	addObjects(cs, items)
+	reader := mustgather.NewReader(root)
+	described := make(map[string]struct{}, len(items))
+	nodes := make(map[string]struct{})
+	for _, item := range items {
+		described[item.GetKind()+"/"+item.GetName()] = struct{}{}
+		if item.GetKind() == "Node" {
+			nodes[item.GetName()] = struct{}{}
+		}
+	}
+	if len(nodes) > 0 {
+		pods, err := reader.List(schema.GroupVersionResource{Version: "v1", Resource: "pods"}, "", metav1.ListOptions{})
+		if err != nil {
+			klog.V(3).ErrorS(err, "Skipping the pods of the nodes")
+		}
+		var scheduled []unstructured.Unstructured
+		for _, pod := range pods {
+			if nodeName, _, _ := unstructured.NestedString(pod.Object, "spec", "nodeName"); nodeName != "" {
+				if _, ok := nodes[nodeName]; ok {
+					scheduled = append(scheduled, pod)
+				}
+			}
+		}
+		addObjects(cs, scheduled)
+	}
+	// the events of cluster-scoped objects are recorded in any namespace (usually "default")
+	eventList, err := reader.Events("")
+	if err != nil {
+		klog.V(3).ErrorS(err, "Skipping the events")
+		return cs
+	}
+	for i := range eventList.Items {
+		e := &eventList.Items[i]
+		if _, ok := described[e.InvolvedObject.Kind+"/"+e.InvolvedObject.Name]; !ok {
+			continue
+		}
+		if err := cs.Tracker().Add(e); err != nil {
+			klog.V(5).ErrorS(err, "Unable to add object to the fake clientset", "kind", "Event", "name", e.Name)
+		}
+	}
+	return cs
+}
+
+// NewSimpleClientset returns a fake clientset holding the given objects which, unlike
+// fake.NewSimpleClientset, honours field selectors on list requests and skips duplicated objects.
+func NewSimpleClientset(objects ...runtime.Object) *fake.Clientset {
+	cs := fake.NewSimpleClientset()
+	// the fake object tracker ignores field selectors, while describers rely on them
+	// (e.g. the pods running on a node or the events involving an object)
+	cs.PrependReactor("list", "*", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		listAction, ok := action.(clienttesting.ListAction)
+		if !ok {
+			return false, nil, nil
+		}
+		selector := listAction.GetListRestrictions().Fields
+		if selector == nil || selector.Empty() {
+			return false, nil, nil
+		}
+		handled, list, err := clienttesting.ObjectReaction(cs.Tracker())(action)
+		if !handled || err != nil {
+			return handled, list, err
+		}
+		return true, list, filterListByFields(list, selector)
+	})
+	for _, obj := range objects {
+		if err := cs.Tracker().Add(obj); err != nil {
+			klog.V(5).ErrorS(err, "Unable to add object to the fake clientset")
+		}
+	}
+	return cs
+}
+
+// resourcesInDir maps every known group directory found in dir to the resources stored in it.
+func resourcesInDir(dir string, groups map[string]struct{}) map[string]map[string]struct{} {
+	resources := make(map[string]map[string]struct{})
+	groupDirs, err := os.ReadDir(dir)
+	if err != nil {
+		return resources
+	}
+	for _, g := range groupDirs {
+		if _, ok := groups[g.Name()]; !ok || !g.IsDir() {
+			continue
+		}
+		entries, err := os.ReadDir(filepath.Join(dir, g.Name()))
+		if err != nil {
+			continue
+		}
+		plurals := make(map[string]struct{})
+		for _, e := range entries {
+			name := strings.TrimSuffix(e.Name(), ".yaml")
+			if len(validation.IsDNS1123Subdomain(name)) != 0 {
+				continue
+			}
+			if e.IsDir() || filepath.Ext(e.Name()) == ".yaml" {
+				plurals[name] = struct{}{}
+			}
+		}
+		resources[g.Name()] = plurals
+	}
+	return resources
+}
+
+// knownGroups returns the must-gather directory names of the groups registered in the clientset scheme.
+func knownGroups() map[string]struct{} {
+	groups := make(map[string]struct{})
+	for gvk := range scheme.Scheme.AllKnownTypes() {
+		if gvk.Group == "" {
+			groups["core"] = struct{}{}
+		} else {
+			groups[gvk.Group] = struct{}{}
+		}
+	}
+	return groups
+}
+
+func addObjects(cs *fake.Clientset, items []unstructured.Unstructured) {
+	for _, item := range items {
+		obj, err := scheme.Scheme.New(item.GroupVersionKind())
+		if err != nil {
+			continue
+		}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, obj); err != nil {
+			klog.V(3).ErrorS(err, "Unable to convert object", "kind", item.GetKind(), "name", item.GetName())
+			continue
+		}
+		if err := cs.Tracker().Add(obj); err != nil {
+			klog.V(5).ErrorS(err, "Unable to add object to the fake clientset", "kind", item.GetKind(), "name", item.GetName())
+		}
+	}
+}
+
+func filterListByFields(list runtime.Object, selector fields.Selector) error {
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	var filtered []runtime.Object
+	for _, item := range items {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(item)
+		if err != nil {
+			return err
+		}
+		if helpers.MatchFieldSelector(content, selector) {
+			filtered = append(filtered, item)
+		}
+	}
+	return meta.SetList(list, filtered)
+}
+
+func exists(path string) (bool, error) {
+	_, err := os.Stat(path)
+	if err == nil {
+		return true, nil
+	}
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return false, err
+}
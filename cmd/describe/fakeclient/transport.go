@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package fakeclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gmeghnag/omc/cmd/helpers"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+// RESTConfig returns a client configuration whose requests are served in-process
+// from the objects held by the given fake clientset, so that consumers which only
+// accept a *rest.Config (e.g. kubectl's describe.DescriberFor) can read the must-gather.
+// Only get and list requests are supported.
+func RESTConfig(cs *fake.Clientset) *rest.Config {
+	resources := make(map[schema.GroupVersionResource]schema.GroupVersionKind)
+	for gvk := range scheme.Scheme.AllKnownTypes() {
+		if strings.HasSuffix(gvk.Kind, "List") || strings.HasSuffix(gvk.Kind, "Options") {
+			continue
+		}
+		plural, _ := meta.UnsafeGuessKindToResource(gvk)
+		resources[plural] = gvk
+	}
+	return &rest.Config{
+		Host:      "http://must-gather.local",
+		Transport: &trackerTransport{tracker: cs.Tracker(), resources: resources},
+	}
+}
+
+type trackerTransport struct {
+	tracker   clienttesting.ObjectTracker
+	resources map[schema.GroupVersionResource]schema.GroupVersionKind
+}
+
+func (t *trackerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return statusResponse(req, apierrors.NewMethodNotSupported(schema.GroupResource{}, req.Method))
+	}
+	gvr, namespace, name, subresource, ok := helpers.ParseAPIPath(req.URL.Path)
+	if !ok || subresource != "" {
+		// subresources are not part of a must-gather
+		return statusResponse(req, apierrors.NewNotFound(schema.GroupResource{}, req.URL.Path))
+	}
+	gvk, ok := t.resources[gvr]
+	if !ok {
+		return statusResponse(req, apierrors.NewNotFound(gvr.GroupResource(), name))
+	}
+	var obj runtime.Object
+	var err error
+	if name != "" {
+		obj, err = t.tracker.Get(gvr, namespace, name)
+	} else {
+		obj, err = t.tracker.List(gvr, gvk, namespace)
+		if err == nil {
+			err = filterList(obj, req.URL.Query().Get("labelSelector"), req.URL.Query().Get("fieldSelector"))
+		}
+	}
+	if err != nil {
+		return statusResponse(req, err)
+	}
+	data, err := runtime.Encode(scheme.Codecs.LegacyCodec(gvr.GroupVersion()), obj)
+	if err != nil {
+		return statusResponse(req, apierrors.NewInternalError(err))
+	}
+	return response(req, http.StatusOK, data), nil
+}
+
+func filterList(list runtime.Object, labelSelector string, fieldSelector string) error {
+	ls, err := labels.Parse(labelSelector)
+	if err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	}
+	fs, err := fields.ParseSelector(fieldSelector)
+	if err != nil {
+		return apierrors.NewBadRequest(err.Error())
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+	var filtered []runtime.Object
+	for _, item := range items {
+		accessor, err := meta.Accessor(item)
+		if err != nil {
+			return err
+		}
+		if ls.Matches(labels.Set(accessor.GetLabels())) {
+			filtered = append(filtered, item)
+		}
+	}
+	if err := meta.SetList(list, filtered); err != nil {
+		return err
+	}
+	if fs.Empty() {
+		return nil
+	}
+	return filterListByFields(list, fs)
+}
+
+func statusResponse(req *http.Request, err error) (*http.Response, error) {
+	status, ok := err.(apierrors.APIStatus)
+	if !ok {
+		status = apierrors.NewInternalError(err)
+	}
+	s := status.Status()
+	s.TypeMeta = metav1.TypeMeta{Kind: "Status", APIVersion: "v1"}
+	data, encodeErr := json.Marshal(s)
+	if encodeErr != nil {
+		return nil, encodeErr
+	}
+	return response(req, int(s.Code), data), nil
+}
+
+func response(req *http.Request, code int, body []byte) *http.Response {
+	return &http.Response{
+		StatusCode: code,
+		Header:     http.Header{"Content-Type": []string{runtime.ContentTypeJSON}},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Request:    req,
+	}
+}
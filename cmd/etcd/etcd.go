@@ -21,6 +21,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var output string
+
 // etcdCmd represents the etcd command
 var Etcd = &cobra.Command{
 	Use:     "etcd",
@@ -39,4 +41,5 @@ func init() {
 		Members,
 		Alarm,
 	)
+	Etcd.PersistentFlags().StringVarP(&output, "output", "o", "", "Output format. One of: csv|tsv|markdown|html")
 }
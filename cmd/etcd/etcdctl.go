@@ -7,7 +7,7 @@ import (
 	"strings"
 
 	"github.com/dustin/go-humanize"
-	"github.com/olekukonko/tablewriter"
+	"github.com/gmeghnag/omc/pkg/tableprinter"
 	etcdserverpb "go.etcd.io/etcd/api/v3/etcdserverpb"
 )
 
@@ -63,8 +63,8 @@ func EndpointStatus(etcdFolderPath string) {
 		os.Exit(1)
 	}
 	var rows [][]string
-	var hdr = []string{"endpoint", "ID", "version", "db size/in use", "not used", "is leader", "is learner", "raft term",
-		"raft index", "raft applied index", "errors"}
+	var hdr = []string{"ENDPOINT", "ID", "VERSION", "DB SIZE/IN USE", "NOT USED", "IS LEADER", "IS LEARNER", "RAFT TERM",
+		"RAFT INDEX", "RAFT APPLIED INDEX", "ERRORS"}
 	for _, status := range Endpoints {
 		rows = append(rows, []string{
 			status.Endpoint,
@@ -80,10 +80,7 @@ func EndpointStatus(etcdFolderPath string) {
 			fmt.Sprint(strings.Join(status.Resp.Errors, ", ")),
 		})
 	}
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader(hdr)
-	table.AppendBulk(rows)
-	table.Render()
+	printTable(hdr, rows)
 }
 
 func EndpointHealth(etcdFolderPath string) {
@@ -98,7 +95,7 @@ func EndpointHealth(etcdFolderPath string) {
 		os.Exit(1)
 	}
 	var rows [][]string
-	var hdr = []string{"endpoint", "health", "took", "error"}
+	var hdr = []string{"ENDPOINT", "HEALTH", "TOOK", "ERROR"}
 	for _, h := range healthList {
 		rows = append(rows, []string{
 			h.Ep,
@@ -107,10 +104,7 @@ func EndpointHealth(etcdFolderPath string) {
 			h.Error,
 		})
 	}
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader(hdr)
-	table.AppendBulk(rows)
-	table.Render()
+	printTable(hdr, rows)
 }
 
 func AlarmList(etcdFolderPath string) {
@@ -147,7 +141,7 @@ func MemberList(etcdFolderPath string) {
 		os.Exit(1)
 	}
 	var rows [][]string
-	var hdr = []string{"ID", "status", "name", "peer addrs", "client addrs", "is learner"}
+	var hdr = []string{"ID", "STATUS", "NAME", "PEER ADDRS", "CLIENT ADDRS", "IS LEARNER"}
 	for _, m := range memberList.Members {
 		status := "started"
 		if len(m.Name) == 0 {
@@ -166,8 +160,15 @@ func MemberList(etcdFolderPath string) {
 			isLearner,
 		})
 	}
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader(hdr)
-	table.AppendBulk(rows)
-	table.Render()
+	printTable(hdr, rows)
+}
+
+// printTable prints the rows bordered as etcdctl does, or in the format selected by -o.
+func printTable(headers []string, rows [][]string) {
+	table := tableprinter.New(tableprinter.Options{Output: output, Bordered: true}, headers...)
+	table.AppendRows(rows)
+	if err := table.Print(os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
 }
@@ -23,14 +23,17 @@ import (
 
 	"github.com/gmeghnag/omc/cmd/get"
 	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/pkg/mustgather"
+	"github.com/gmeghnag/omc/pkg/tableprinter"
 	"github.com/gmeghnag/omc/vars"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	cliprint "k8s.io/cli-runtime/pkg/printers"
 	"k8s.io/klog/v2"
 	api "k8s.io/kubernetes/pkg/apis/core"
 	"k8s.io/kubernetes/pkg/printers"
-	"sigs.k8s.io/yaml"
 
 	"github.com/spf13/cobra"
 )
@@ -46,7 +49,15 @@ var EventsCmd = &cobra.Command{
 		}
 		eventList := GetEventList(vars.MustGatherRootPath, vars.Namespace, vars.AllNamespaceBoolVar)
 		FilterEventList(&eventList, vars.EventTypes, vars.ForResource)
+		if err := FilterEventListByLabels(&eventList, vars.LabelSelectorStringVar); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
 		SortEventList(&eventList)
+		if err := SortEventListBy(&eventList, vars.SortBy, vars.SortReverse); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
 		PrintEventList(&eventList, vars.MustGatherRootPath, vars.OutputStringVar, vars.Namespace, vars.AllNamespaceBoolVar)
 	},
 }
@@ -55,7 +66,10 @@ func init() {
 	EventsCmd.PersistentFlags().BoolVarP(&vars.AllNamespaceBoolVar, "all-namespaces", "A", false, "If present, list the requested object(s) across all namespaces.")
 	EventsCmd.PersistentFlags().StringVar(&vars.ForResource, "for", "", "Filter events to only those pertaining to the specified resource.")
 	EventsCmd.PersistentFlags().StringSliceVar(&vars.EventTypes, "types", vars.EventTypes, "Output only events of given types.")
-	EventsCmd.PersistentFlags().StringVarP(&vars.OutputStringVar, "output", "o", "", "Output format. One of: json|yaml|name")
+	EventsCmd.PersistentFlags().StringVarP(&vars.OutputStringVar, "output", "o", "", "Output format. One of: json|yaml|name|csv|tsv|markdown|html")
+	EventsCmd.PersistentFlags().StringVar(&vars.SortBy, "sort-by", "", "If non-empty, sort the events using this field specification instead of their last time, comparing numbers and RFC3339 times by value (e.g. '{.count}').")
+	EventsCmd.PersistentFlags().BoolVar(&vars.SortReverse, "reverse", false, "If present, sort the events in descending order.")
+	EventsCmd.PersistentFlags().StringVarP(&vars.LabelSelectorStringVar, "selector", "l", "", "Selector (label query) to filter on, supports '=', '==', '!=', 'in', 'notin', 'key' and '!key'.(e.g. -l key1=value1,key2 in (value2,value3),!key3)")
 }
 
 func Validate() error {
@@ -66,43 +80,15 @@ func Validate() error {
 }
 
 func GetEventList(context string, selectedNs string, allNamespaces bool) (eventList corev1.EventList) {
-	eventsLocation := "/core/events.yaml"
-	nsFolder := context + "/namespaces/"
-	var namespaces []string
 	if allNamespaces {
-		fileObj, err := os.Open(nsFolder)
-		if err != nil {
-			klog.ErrorS(err, "Unable to read "+nsFolder)
-			os.Exit(1)
-		}
-		namespaces, err = fileObj.Readdirnames(0)
-		if err != nil {
-			klog.ErrorS(err, "Unable to list directories in "+nsFolder)
-			os.Exit(1)
-		}
-	} else {
-		namespaces = append(namespaces, selectedNs)
+		selectedNs = ""
 	}
-
-	for _, namespace := range namespaces {
-		eventsPath := nsFolder + namespace + eventsLocation
-		eventsFile, err := os.ReadFile(eventsPath)
-		if err != nil {
-			klog.V(5).ErrorS(err, "Unable to read events.yaml")
-			continue
-		}
-		var nsEvents corev1.EventList
-		if err := yaml.Unmarshal([]byte(eventsFile), &nsEvents); err != nil {
-			klog.V(3).ErrorS(err, "Unable to parse Kubernetes EventList object from "+eventsPath)
-			continue
-		}
-
-		if eventList.Kind == "" {
-			eventList.SetGroupVersionKind(nsEvents.GroupVersionKind())
-		}
-		eventList.Items = append(eventList.Items, nsEvents.Items...)
+	events, err := mustgather.NewReader(context).Events(selectedNs)
+	if err != nil {
+		klog.ErrorS(err, "Unable to read "+context+"/namespaces/")
+		os.Exit(1)
 	}
-	return eventList
+	return *events
 }
 
 func FilterEventList(eventList *corev1.EventList, types []string, forResource string) {
@@ -142,6 +128,24 @@ func FilterEventList(eventList *corev1.EventList, types []string, forResource st
 	}
 }
 
+func FilterEventListByLabels(eventList *corev1.EventList, selector string) error {
+	if selector == "" {
+		return nil
+	}
+	labelSelector, err := helpers.ParseLabelSelector(selector)
+	if err != nil {
+		return fmt.Errorf("invalid label selector %q: %w", selector, err)
+	}
+	var filtered []corev1.Event
+	for _, event := range eventList.Items {
+		if labelSelector.Matches(labels.Set(event.GetLabels())) {
+			filtered = append(filtered, event)
+		}
+	}
+	eventList.Items = filtered
+	return nil
+}
+
 func SortEventList(eventList *corev1.EventList) {
 	events := eventList.Items
 	slices.SortFunc(events, func(i, j corev1.Event) int {
@@ -149,6 +153,24 @@ func SortEventList(eventList *corev1.EventList) {
 	})
 }
 
+// SortEventListBy sorts events, already sorted by time, by a field as "omc get --sort-by" does,
+// keeping their time order for equal values; without field, they are only reversed if requested.
+func SortEventListBy(eventList *corev1.EventList, field string, reverse bool) error {
+	if field == "" {
+		if reverse {
+			slices.Reverse(eventList.Items)
+		}
+		return nil
+	}
+	return get.Sort(eventList.Items, field, reverse, func(event corev1.Event) map[string]interface{} {
+		object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&event)
+		if err != nil {
+			klog.V(3).ErrorS(err, "Unable to convert event", "name", event.Name)
+		}
+		return object
+	})
+}
+
 func PrintEventList(eventList *corev1.EventList, context string, output string, selectedNs string, allNamespaces bool) {
 	if len(eventList.Items) == 0 {
 		if allNamespaces {
@@ -203,8 +225,12 @@ func PrintEventList(eventList *corev1.EventList, context string, output string,
 			}
 		}
 
-		cliPrinter = cliprint.NewTablePrinter(cliprint.PrintOptions{})
-		err = cliPrinter.PrintObj(table, os.Stdout)
+		if tableprinter.IsDelimited(output) || tableprinter.IsDocument(output) {
+			err = tableprinter.FromMetaTable(tableprinter.Options{Output: output}, table, true).Print(os.Stdout)
+		} else {
+			cliPrinter = cliprint.NewTablePrinter(cliprint.PrintOptions{})
+			err = cliPrinter.PrintObj(table, os.Stdout)
+		}
 		if err != nil {
 			klog.V(3).ErrorS(err, "Error when outputting table of events")
 		}
@@ -133,3 +133,95 @@ func TestFilterOnResource(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterEventListByLabels(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "Empty selector matches everything",
+			selector: "",
+			expected: []string{"test1", "test2"},
+		},
+		{
+			name:     "Set based selector",
+			selector: "app in (etcd,kube-apiserver)",
+			expected: []string{"test1"},
+		},
+		{
+			name:     "Key does not exist",
+			selector: "!app",
+			expected: []string{"test2"},
+		},
+		{
+			name:     "Invalid selector",
+			selector: "app in (etcd",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testData := corev1.EventList{
+				Items: []corev1.Event{
+					{ObjectMeta: metav1.ObjectMeta{Name: "test1", Labels: map[string]string{"app": "etcd"}}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "test2"}},
+				}}
+			err := FilterEventListByLabels(&testData, tt.selector)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error for selector %q, got nil", tt.selector)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			actual := []string{}
+			for _, event := range testData.Items {
+				actual = append(actual, event.Name)
+			}
+			if !reflect.DeepEqual(tt.expected, actual) {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSortEventListBy(t *testing.T) {
+	tests := []struct {
+		name     string
+		field    string
+		reverse  bool
+		expected []string
+	}{
+		{name: "Time order", expected: []string{"test1", "test2", "test3"}},
+		{name: "Reversed time order", reverse: true, expected: []string{"test3", "test2", "test1"}},
+		{name: "Count, equal counts in time order", field: "{.count}", expected: []string{"test2", "test1", "test3"}},
+		{name: "Reversed count", field: ".count", reverse: true, expected: []string{"test1", "test3", "test2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testData := corev1.EventList{
+				Items: []corev1.Event{
+					{ObjectMeta: metav1.ObjectMeta{Name: "test1"}, Count: 10},
+					{ObjectMeta: metav1.ObjectMeta{Name: "test2"}, Count: 9},
+					{ObjectMeta: metav1.ObjectMeta{Name: "test3"}, Count: 10},
+				}}
+			if err := SortEventListBy(&testData, tt.field, tt.reverse); err != nil {
+				t.Fatal(err)
+			}
+			actual := []string{}
+			for _, event := range testData.Items {
+				actual = append(actual, event.Name)
+			}
+			if !reflect.DeepEqual(tt.expected, actual) {
+				t.Errorf("expected %v, got %v", tt.expected, actual)
+			}
+		})
+	}
+}
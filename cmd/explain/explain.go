@@ -0,0 +1,246 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package explain
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/gmeghnag/omc/cmd/get"
+	"github.com/gmeghnag/omc/pkg/mustgather"
+	"github.com/gmeghnag/omc/vars"
+
+	openshiftapi "github.com/openshift/api"
+	"github.com/spf13/cobra"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	kscheme "k8s.io/client-go/kubernetes/scheme"
+)
+
+// lineWidth is the width descriptions are wrapped to.
+const lineWidth = 80
+
+var (
+	apiVersion string
+	recursive  bool
+)
+
+// typesScheme holds the versioned built-in types, which unlike the internal types known to
+// "omc get" are documented.
+var typesScheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(kscheme.AddToScheme(typesScheme))
+	utilruntime.Must(openshiftapi.Install(typesScheme))
+}
+
+var ExplainCmd = &cobra.Command{
+	Use:   "explain <resource>[.<field>...]",
+	Short: "Get the documentation of a resource and of its fields.",
+	Long: `Describe the fields of a resource, and of their own fields at any depth. Custom resources
+are documented by the OpenAPI schema of their CRD, either stored in the must-gather or in
+~/.omc/customresourcedefinitions, built-in resources by the documentation of their types.`,
+	Example: `  omc explain pods
+  omc explain deployments.spec.strategy
+  omc explain machineconfigs.spec --recursive
+  omc explain horizontalpodautoscalers --api-version=autoscaling/v1`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		reader := mustgather.NewReader(vars.MustGatherRootPath)
+		return explain(os.Stdout, reader, args[0], apiVersion, recursive)
+	},
+}
+
+func init() {
+	ExplainCmd.Flags().StringVar(&apiVersion, "api-version", "", "Use the given api-version (group/version) of the resource, its preferred version otherwise.")
+	ExplainCmd.Flags().BoolVar(&recursive, "recursive", false, "Print the fields of fields, without their descriptions.")
+}
+
+// explain prints the documentation of a resource, or of one of its fields given as
+// <resource>.<field>.<field>...
+func explain(w io.Writer, reader *mustgather.Reader, arg string, apiVersion string, recursive bool) error {
+	path := strings.Split(arg, ".")
+	resource := strings.ToLower(path[0])
+	var gv schema.GroupVersion
+	if apiVersion != "" {
+		var err error
+		if gv, err = schema.ParseGroupVersion(apiVersion); err != nil {
+			return err
+		}
+	}
+	alias := resource
+	if gv.Group != "" {
+		alias += "." + gv.Group
+	}
+	plural, group, singular, _, err := get.KindGroupNamespaced(alias)
+	if err != nil {
+		return fmt.Errorf("resource type \"%s\" not known.", resource)
+	}
+	if group == "core" {
+		group = ""
+	}
+	gvk, root, err := kindSchema(reader, schema.GroupResource{Group: group, Resource: plural}, singular, gv.Version)
+	if err != nil {
+		return err
+	}
+	name, n, err := lookup(root, path[1:])
+	if err != nil {
+		return err
+	}
+
+	if gvk.Group != "" {
+		fmt.Fprintf(w, "GROUP:      %s\n", gvk.Group)
+	}
+	fmt.Fprintf(w, "KIND:       %s\nVERSION:    %s\n\n", gvk.Kind, gvk.Version)
+	if name != "" {
+		fmt.Fprintf(w, "FIELD: %s <%s>\n\n", name, n.typeName())
+	}
+	fmt.Fprintln(w, "DESCRIPTION:")
+	description := n.description()
+	if description == "" {
+		description = "<empty>"
+	}
+	writeWrapped(w, description, "    ")
+	fields := n.fields()
+	if len(fields) == 0 {
+		return nil
+	}
+	fmt.Fprintln(w, "\nFIELDS:")
+	printFields(w, fields, "  ", recursive, nil)
+	return nil
+}
+
+// kindSchema returns the kind of a resource and its schema, either defined by its CRD or by its
+// built-in type, in the given version or in its preferred one if empty.
+func kindSchema(reader *mustgather.Reader, gr schema.GroupResource, singular string, requestedVersion string) (schema.GroupVersionKind, node, error) {
+	for _, crd := range get.CustomResourceDefinitions(reader) {
+		if crd.Spec.Group != gr.Group || crd.Spec.Names.Plural != gr.Resource {
+			continue
+		}
+		v := crdVersion(crd, requestedVersion)
+		if v == nil {
+			return schema.GroupVersionKind{}, nil, fmt.Errorf("the customresourcedefinition %s does not define version %s", crd.Name, requestedVersion)
+		}
+		gvk := schema.GroupVersionKind{Group: gr.Group, Version: v.Name, Kind: crd.Spec.Names.Kind}
+		if v.Schema == nil || v.Schema.OpenAPIV3Schema == nil {
+			return gvk, nil, fmt.Errorf("the customresourcedefinition %s has no schema for version %s", crd.Name, v.Name)
+		}
+		return gvk, openAPINode{props: v.Schema.OpenAPIV3Schema, root: true}, nil
+	}
+	var found schema.GroupVersionKind
+	var t reflect.Type
+	for gvk, knownType := range typesScheme.AllKnownTypes() {
+		if gvk.Group != gr.Group || strings.ToLower(gvk.Kind) != singular || gvk.Version == runtime.APIVersionInternal {
+			continue
+		}
+		if requestedVersion != "" && gvk.Version != requestedVersion {
+			continue
+		}
+		if found.Kind == "" || version.CompareKubeAwareVersionStrings(gvk.Version, found.Version) > 0 {
+			found, t = gvk, knownType
+		}
+	}
+	if t == nil {
+		return schema.GroupVersionKind{}, nil, fmt.Errorf("no documentation found for %s", gr.String())
+	}
+	return found, rootGoNode(t), nil
+}
+
+// crdVersion returns the requested version of a CRD, or if empty its storage version, or its first
+// served one.
+func crdVersion(crd apiextensionsv1.CustomResourceDefinition, requestedVersion string) *apiextensionsv1.CustomResourceDefinitionVersion {
+	var served *apiextensionsv1.CustomResourceDefinitionVersion
+	for i := range crd.Spec.Versions {
+		v := &crd.Spec.Versions[i]
+		switch {
+		case requestedVersion != "":
+			if v.Name == requestedVersion {
+				return v
+			}
+		case v.Storage:
+			return v
+		case v.Served && served == nil:
+			served = v
+		}
+	}
+	return served
+}
+
+// printFields prints fields with their descriptions, or the fields of their fields without
+// descriptions if recursive. types holds the built-in types being printed, whose recursive
+// fields are only printed once.
+func printFields(w io.Writer, fields []field, indent string, recursive bool, types []reflect.Type) {
+	for i, f := range fields {
+		required := ""
+		if f.required {
+			required = " -required-"
+		}
+		fmt.Fprintf(w, "%s%s\t<%s>%s\n", indent, f.name, f.schema.typeName(), required)
+		if !recursive {
+			description := f.schema.description()
+			if description == "" {
+				description = "<no description>"
+			}
+			writeWrapped(w, description, indent+"  ")
+			if i < len(fields)-1 {
+				fmt.Fprintln(w)
+			}
+			continue
+		}
+		nested := types
+		if n, ok := f.schema.(goNode); ok {
+			if containsType(types, n.t) {
+				continue
+			}
+			nested = append(append([]reflect.Type{}, types...), n.t)
+		}
+		printFields(w, f.schema.fields(), indent+"  ", recursive, nested)
+	}
+}
+
+func containsType(types []reflect.Type, t reflect.Type) bool {
+	for _, known := range types {
+		if known == t {
+			return true
+		}
+	}
+	return false
+}
+
+// writeWrapped writes a text indented and wrapped to lineWidth, keeping its line breaks.
+func writeWrapped(w io.Writer, text string, indent string) {
+	for _, paragraph := range strings.Split(strings.TrimSpace(text), "\n") {
+		line := indent
+		for _, word := range strings.Fields(paragraph) {
+			if line != indent && len(line)+1+len(word) > lineWidth {
+				fmt.Fprintln(w, line)
+				line = indent
+			}
+			if line != indent {
+				line += " "
+			}
+			line += word
+		}
+		fmt.Fprintln(w, strings.TrimRight(line, " "))
+	}
+}
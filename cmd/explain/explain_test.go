@@ -0,0 +1,183 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package explain
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gmeghnag/omc/internal/testutil"
+	"github.com/gmeghnag/omc/pkg/mustgather"
+	"github.com/gmeghnag/omc/vars"
+)
+
+func TestExplain(t *testing.T) {
+	root := testutil.MustGather(t, map[string]string{
+		"cluster-scoped-resources/apiextensions.k8s.io/customresourcedefinitions/foos.example.com.yaml": `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: foos.example.com
+spec:
+  group: example.com
+  names:
+    kind: Foo
+    plural: foos
+    singular: foo
+  scope: Namespaced
+  versions:
+  - name: v1alpha1
+    served: true
+    schema:
+      openAPIV3Schema:
+        type: object
+  - name: v1
+    served: true
+    storage: true
+    schema:
+      openAPIV3Schema:
+        description: Foo is a test resource.
+        type: object
+        properties:
+          metadata:
+            type: object
+          spec:
+            description: spec holds the desired state of the foo.
+            type: object
+            required:
+            - size
+            properties:
+              size:
+                description: size is the number of bars of the foo.
+                type: integer
+              bars:
+                type: array
+                items:
+                  type: object
+                  properties:
+                    name:
+                      type: string
+              labels:
+                type: object
+                additionalProperties:
+                  type: string
+`,
+	})
+	savedPath := vars.MustGatherRootPath
+	t.Cleanup(func() { vars.MustGatherRootPath = savedPath })
+	vars.MustGatherRootPath = root
+	reader := mustgather.NewReader(root)
+
+	tests := []struct {
+		name       string
+		arg        string
+		apiVersion string
+		recursive  bool
+		want       []string
+		notWant    []string
+		wantErr    string
+	}{
+		{
+			name: "built-in kind",
+			arg:  "pods",
+			want: []string{"KIND:       Pod\nVERSION:    v1\n", "Pod is a collection of containers", "  spec\t<PodSpec>\n", "  metadata\t<ObjectMeta>\n"},
+		},
+		{
+			name: "built-in field",
+			arg:  "deploy.spec.template.spec.containers",
+			want: []string{"GROUP:      apps\n", "FIELD: containers <[]Container>\n", "  image\t<string>\n", "  resources\t<ResourceRequirements>\n"},
+		},
+		{
+			name: "inlined fields",
+			arg:  "svc",
+			want: []string{"  apiVersion\t<string>\n", "  kind\t<string>\n"},
+		},
+		{
+			name:       "built-in version",
+			arg:        "hpa.spec",
+			apiVersion: "autoscaling/v1",
+			want:       []string{"VERSION:    v1\n", "targetCPUUtilizationPercentage\t<integer>"},
+		},
+		{
+			name:      "built-in recursive",
+			arg:       "pod.spec.containers.resources",
+			recursive: true,
+			want:      []string{"  claims\t<[]ResourceClaim>\n    name\t<string>\n", "  limits\t<map[string]Quantity>\n"},
+			notWant:   []string{"Limits describes"},
+		},
+		{
+			name: "openshift kind",
+			arg:  "routes.spec.host",
+			want: []string{"GROUP:      route.openshift.io\n", "FIELD: host <string>\n", "host is an alias/DNS"},
+		},
+		{
+			name: "custom resource",
+			arg:  "foo",
+			want: []string{"KIND:       Foo\nVERSION:    v1\n", "Foo is a test resource.", "  metadata\t<ObjectMeta>\n    Standard object's metadata.", "  spec\t<Object>\n"},
+		},
+		{
+			name: "custom resource field",
+			arg:  "foos.spec",
+			want: []string{"FIELD: spec <Object>\n", "  bars\t<[]Object>\n    <no description>\n", "  labels\t<map[string]string>\n", "  size\t<integer> -required-\n    size is the number of bars of the foo.\n"},
+		},
+		{
+			name:      "custom resource recursive",
+			arg:       "foo.spec",
+			recursive: true,
+			want:      []string{"  bars\t<[]Object>\n    name\t<string>\n  labels\t<map[string]string>\n  size\t<integer> -required-\n"},
+		},
+		{
+			name:       "custom resource version",
+			arg:        "foo",
+			apiVersion: "example.com/v1alpha1",
+			want:       []string{"VERSION:    v1alpha1\n", "DESCRIPTION:\n    <empty>\n"},
+			notWant:    []string{"FIELDS:"},
+		},
+		{name: "unknown field", arg: "foo.spec.missing", wantErr: `field "missing" does not exist`},
+		{name: "unknown version", arg: "foo", apiVersion: "example.com/v2", wantErr: "the customresourcedefinition foos.example.com does not define version v2"},
+		{name: "unknown resource", arg: "missings", wantErr: `resource type "missings" not known.`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			err := explain(&out, reader, tc.arg, tc.apiVersion, tc.recursive)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("expected error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("expected %q in:\n%s", want, out.String())
+				}
+			}
+			for _, notWant := range tc.notWant {
+				if strings.Contains(out.String(), notWant) {
+					t.Errorf("unexpected %q in:\n%s", notWant, out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestWriteWrapped(t *testing.T) {
+	var out bytes.Buffer
+	text := strings.Repeat("word ", 20) + "\nsecond paragraph"
+	writeWrapped(&out, text, "    ")
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 lines, got %q", out.String())
+	}
+	for _, line := range lines {
+		if len(line) > lineWidth || !strings.HasPrefix(line, "    ") {
+			t.Errorf("unexpected line %q", line)
+		}
+	}
+	if lines[2] != "    second paragraph" {
+		t.Errorf("expected the line break to be kept, got %q", lines[2])
+	}
+}
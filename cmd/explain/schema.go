@@ -0,0 +1,263 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package explain
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// node is the schema of a kind or of one of its fields.
+type node interface {
+	// typeName is the type printed between angle brackets, e.g. "string" or "[]Container".
+	typeName() string
+	description() string
+	// fields returns the fields of an object, or of the items of an array or a map of objects,
+	// sorted by name.
+	fields() []field
+}
+
+type field struct {
+	name     string
+	required bool
+	schema   node
+}
+
+// lookup returns the schema of the field at the given path, e.g. ["spec", "containers"].
+func lookup(n node, path []string) (string, node, error) {
+	name := ""
+	for _, segment := range path {
+		var found bool
+		for _, f := range n.fields() {
+			if f.name == segment {
+				name, n, found = f.name, f.schema, true
+				break
+			}
+		}
+		if !found {
+			return "", nil, fmt.Errorf("field \"%s\" does not exist", segment)
+		}
+	}
+	return name, n, nil
+}
+
+// swaggerDocumented is implemented by the built-in types, documenting the type under the
+// empty key and each field under its JSON name.
+type swaggerDocumented interface {
+	SwaggerDoc() map[string]string
+}
+
+// goNode is the schema of a built-in type, documented by its SwaggerDoc.
+type goNode struct {
+	t   reflect.Type
+	doc string
+}
+
+func newGoNode(t reflect.Type, doc string) goNode {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return goNode{t: t, doc: doc}
+}
+
+// rootGoNode returns the schema of a built-in kind, documented by the documentation of its type.
+func rootGoNode(t reflect.Type) goNode {
+	n := newGoNode(t, "")
+	n.doc = swaggerDoc(n.t)[""]
+	return n
+}
+
+var (
+	timeType        = reflect.TypeOf(metav1.Time{})
+	microTimeType   = reflect.TypeOf(metav1.MicroTime{})
+	quantityType    = reflect.TypeOf(resource.Quantity{})
+	intOrStringType = reflect.TypeOf(intstr.IntOrString{})
+	rawType         = reflect.TypeOf(runtime.RawExtension{})
+	jsonType        = reflect.TypeOf(apiextensionsv1.JSON{})
+	objectMetaType  = reflect.TypeOf(metav1.ObjectMeta{})
+)
+
+func (n goNode) typeName() string {
+	switch n.t {
+	case timeType, microTimeType:
+		return "string"
+	case quantityType:
+		return "Quantity"
+	case intOrStringType:
+		return "IntOrString"
+	case rawType, jsonType:
+		return "Object"
+	}
+	switch n.t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		if n.t.Elem().Kind() == reflect.Uint8 {
+			// bytes are base64 encoded
+			return "string"
+		}
+		return "[]" + newGoNode(n.t.Elem(), "").typeName()
+	case reflect.Map:
+		return "map[string]" + newGoNode(n.t.Elem(), "").typeName()
+	case reflect.Struct:
+		return n.t.Name()
+	}
+	return "Object"
+}
+
+func (n goNode) description() string {
+	return n.doc
+}
+
+func (n goNode) fields() []field {
+	t := n.t
+	for {
+		switch {
+		case t.Kind() == reflect.Pointer, t.Kind() == reflect.Map, (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t.Elem().Kind() != reflect.Uint8:
+			t = t.Elem()
+			continue
+		}
+		break
+	}
+	switch t {
+	case timeType, microTimeType, quantityType, intOrStringType, rawType, jsonType:
+		return nil
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	fields := structFields(t)
+	sort.Slice(fields, func(i, j int) bool { return fields[i].name < fields[j].name })
+	return fields
+}
+
+// structFields returns the fields of a struct as serialized to JSON, inlining embedded structs.
+func structFields(t reflect.Type) []field {
+	docs := swaggerDoc(t)
+	var fields []field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name, options, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" && (sf.Anonymous || strings.Contains(options, "inline")) {
+			embedded := sf.Type
+			for embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				fields = append(fields, structFields(embedded)...)
+			}
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		fields = append(fields, field{name: name, schema: newGoNode(sf.Type, docs[name])})
+	}
+	return fields
+}
+
+func swaggerDoc(t reflect.Type) map[string]string {
+	if documented, ok := reflect.New(t).Elem().Interface().(swaggerDocumented); ok {
+		return documented.SwaggerDoc()
+	}
+	return nil
+}
+
+// openAPINode is the schema of a custom resource, or of one of its fields, as defined by its CRD.
+type openAPINode struct {
+	props *apiextensionsv1.JSONSchemaProps
+	// root is set for the schema of the kind, whose metadata is documented as an ObjectMeta
+	// unless the CRD describes it
+	root bool
+}
+
+func (n openAPINode) typeName() string {
+	p := n.props
+	if p.XIntOrString {
+		return "IntOrString"
+	}
+	switch p.Type {
+	case "array":
+		if p.Items == nil || p.Items.Schema == nil {
+			return "[]Object"
+		}
+		return "[]" + openAPINode{props: p.Items.Schema}.typeName()
+	case "object", "":
+		if len(p.Properties) == 0 && p.AdditionalProperties != nil && p.AdditionalProperties.Schema != nil {
+			return "map[string]" + openAPINode{props: p.AdditionalProperties.Schema}.typeName()
+		}
+		return "Object"
+	}
+	return p.Type
+}
+
+func (n openAPINode) description() string {
+	return n.props.Description
+}
+
+func (n openAPINode) fields() []field {
+	p := n.props
+	for {
+		switch {
+		case p.Type == "array" && p.Items != nil && p.Items.Schema != nil:
+			p = p.Items.Schema
+			continue
+		case len(p.Properties) == 0 && p.AdditionalProperties != nil && p.AdditionalProperties.Schema != nil:
+			p = p.AdditionalProperties.Schema
+			continue
+		}
+		break
+	}
+	required := make(map[string]bool)
+	for _, name := range p.Required {
+		required[name] = true
+	}
+	var fields []field
+	for name := range p.Properties {
+		props := p.Properties[name]
+		var schema node = openAPINode{props: &props}
+		if n.root && name == "metadata" && len(props.Properties) == 0 {
+			doc := props.Description
+			if doc == "" {
+				doc = metav1.PartialObjectMetadata{}.SwaggerDoc()["metadata"]
+			}
+			schema = newGoNode(objectMetaType, doc)
+		}
+		fields = append(fields, field{name: name, required: required[name], schema: schema})
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].name < fields[j].name })
+	return fields
+}
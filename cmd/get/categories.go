@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package get
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"github.com/gmeghnag/omc/pkg/mustgather"
+	"github.com/gmeghnag/omc/vars"
+)
+
+// allResources are the built-in resources of the "all" category, in the order they are printed.
+var allResources = []string{
+	"pods.core",
+	"services.core",
+	"daemonsets.apps",
+	"deployments.apps",
+	"replicasets.apps",
+	"statefulsets.apps",
+	"replicationcontrollers.core",
+	"deploymentconfigs.apps.openshift.io",
+	"builds.build.openshift.io",
+	"buildconfigs.build.openshift.io",
+	"jobs.batch",
+	"cronjobs.batch",
+	"routes.route.openshift.io",
+	"ingresses.networking.k8s.io",
+}
+
+// categoryIndex maps each category listed in the spec.names.categories of the given CRDs to its
+// member resources, as "<plural>.<group>" sorted by name.
+func categoryIndex(crds []apiextensionsv1.CustomResourceDefinition) map[string][]string {
+	index := make(map[string][]string)
+	for _, crd := range crds {
+		resource := crd.Spec.Names.Plural + "." + crd.Spec.Group
+		for _, category := range crd.Spec.Names.Categories {
+			category = strings.ToLower(category)
+			if !slices.Contains(index[category], resource) {
+				index[category] = append(index[category], resource)
+			}
+		}
+	}
+	for _, resources := range index {
+		sort.Strings(resources)
+	}
+	return index
+}
+
+// categoryResources returns the resources of a category, as "<plural>.<group>": the built-in ones
+// of the "all" category followed by the custom resources whose CRD, either in the must-gather or in
+// ~/.omc/customresourcedefinitions, lists the category in its spec.names.categories.
+func categoryResources(category string) []string {
+	var resources []string
+	if category == "all" {
+		resources = append(resources, allResources...)
+	}
+	index := categoryIndex(CustomResourceDefinitions(mustgather.NewReader(vars.MustGatherRootPath)))
+	return append(resources, index[category]...)
+}
@@ -17,12 +17,10 @@ limitations under the License.
 package get
 
 import (
-	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"regexp"
-	"slices"
 	"sort"
 	"strings"
 
@@ -30,8 +28,7 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	cliprint "k8s.io/cli-runtime/pkg/printers"
-	"k8s.io/client-go/util/jsonpath"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	"k8s.io/kube-aggregator/pkg/apis/apiregistration"
 	"k8s.io/kubernetes/pkg/printers"
@@ -48,7 +45,9 @@ import (
 
 	"github.com/gmeghnag/omc/cmd/helpers"
 	"github.com/gmeghnag/omc/pkg/deserializer"
+	"github.com/gmeghnag/omc/pkg/mustgather"
 	"github.com/gmeghnag/omc/pkg/tablegenerator"
+	"github.com/gmeghnag/omc/pkg/tableprinter"
 	"github.com/gmeghnag/omc/types"
 	"github.com/gmeghnag/omc/vars"
 
@@ -76,41 +75,30 @@ var yamlData []byte
 
 var GetCmd = &cobra.Command{
 	Use:          "get",
-	Short:        "Get kubernetes/openshift object in tabular format or wide|yaml|json|jsonpath|custom-columns.",
+	Short:        "Get kubernetes/openshift object in tabular format or wide|yaml|json|jsonpath|custom-columns|go-template.",
 	SilenceUsage: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if len(args) == 0 {
 			return cmd.Help()
 		}
-		if vars.OutputStringVar == "wide" {
+		// csv and tsv print the wide columns as well, spreadsheets being read column by column
+		if vars.OutputStringVar == "wide" || tableprinter.IsDelimited(vars.OutputStringVar) {
 			vars.Wide = true
 		}
-		if err := validateArgs(args); err != nil {
+		output, err := resolveOutputFormat(vars.OutputStringVar, vars.Template)
+		if err != nil {
 			return err
 		}
-		for resource := range vars.GetArgs {
-			resourceNamePlural, resourceGroup, _, namespaced, err := KindGroupNamespaced(resource)
-			if err != nil {
-				klog.V(1).ErrorS(err, "ERROR")
-				return err
-			}
-			// namespaces and projects resources
-			// are exceptions to must-gather resources structure
-			switch {
-			case resourceNamePlural == "namespaces" || resourceNamePlural == "projects":
-				err = getNamespacesResources(vars.GetArgs[resourceNamePlural+"."+resourceGroup])
-			case resourceNamePlural == "podnetworkconnectivitychecks":
-				err = getPodNetworkConnectivityChecksResources(vars.GetArgs[resourceNamePlural+"."+resourceGroup])
-			case namespaced:
-				err = getNamespacedResources(resourceNamePlural, resourceGroup, vars.GetArgs[resourceNamePlural+"."+resourceGroup])
-			default:
-				err = getClusterScopedResources(resourceNamePlural, resourceGroup, vars.GetArgs[resourceNamePlural+"."+resourceGroup])
-			}
-			if err != nil {
-				return err
-			}
+		vars.OutputStringVar = output
+		resources, err := validateArgs(args)
+		if err != nil {
+			return err
+		}
+		g := &getter{}
+		if err := g.getResources(resources); err != nil {
+			return err
 		}
-		return handleOutput(os.Stdout, os.Stderr)
+		return g.handleOutput(os.Stdout, os.Stderr)
 	},
 }
 
@@ -118,10 +106,16 @@ func init() {
 	GetCmd.PersistentFlags().BoolVarP(&vars.AllNamespaceBoolVar, "all-namespaces", "A", false, "If present, list the requested object(s) across all namespaces.")
 	GetCmd.PersistentFlags().BoolVar(&vars.NoHeaders, "no-headers", false, "When using the default or custom-column output format, don't print headers (default print headers).")
 	GetCmd.PersistentFlags().BoolVar(&vars.ShowManagedFields, "show-managed-fields", false, "If true, show the managedFields when printing objects in JSON or YAML format.")
+	GetCmd.PersistentFlags().BoolVar(&vars.ShowKind, "show-kind", false, "If present, list the resource type for the requested object(s).")
 	GetCmd.PersistentFlags().BoolVarP(&vars.ShowLabelsBoolVar, "show-labels", "", false, "When printing, show all labels as the last column (default hide labels column)")
-	GetCmd.PersistentFlags().StringVarP(&vars.OutputStringVar, "output", "o", "", "Output format. One of: json|yaml|wide|jsonpath|custom-columns=...")
-	GetCmd.PersistentFlags().StringVarP(&vars.LabelSelectorStringVar, "selector", "l", "", "selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
-	GetCmd.PersistentFlags().StringVarP(&vars.SortBy, "sort-by", "", "", "If non-empty, sort list types using this field specification. The field specification is expressed as a JSONPath expression (e.g. '{.metadata.name}').")
+	GetCmd.PersistentFlags().StringSliceVarP(&vars.LabelColumns, "label-columns", "L", []string{}, "Accepts a comma separated list of labels that are going to be presented as columns. Names are case-sensitive. You can also use multiple flag options like -L label1 -L label2...")
+	GetCmd.PersistentFlags().StringVarP(&vars.OutputStringVar, "output", "o", "", "Output format. One of: json|yaml|wide|csv|tsv|markdown|html|name|jsonpath=...|jsonpath-file=...|custom-columns=...|custom-columns-file=...|go-template=...|go-template-file=...")
+	GetCmd.PersistentFlags().StringVar(&vars.Template, "template", "", "Template string or path to template file to use when -o=go-template, -o=go-template-file, -o=jsonpath or -o=jsonpath-file. The template format is golang templates [http://golang.org/pkg/text/template/#pkg-overview].")
+	GetCmd.PersistentFlags().StringVarP(&vars.LabelSelectorStringVar, "selector", "l", "", "Selector (label query) to filter on, supports '=', '==', '!=', 'in', 'notin', 'key' and '!key'.(e.g. -l key1=value1,key2 in (value2,value3),!key3)")
+	GetCmd.PersistentFlags().StringVar(&vars.FieldSelectorStringVar, "field-selector", "", "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2)")
+	GetCmd.PersistentFlags().IntVar(&vars.Parallelism, "parallelism", vars.Parallelism, "Number of namespaces to read concurrently with --all-namespaces, defaults to the number of CPUs (can be persisted with \"omc config --parallelism=<N>\").")
+	GetCmd.PersistentFlags().StringVarP(&vars.SortBy, "sort-by", "", "", "If non-empty, sort the objects of all the requested resources and namespaces using this field specification, comparing numbers, quantities and RFC3339 times by value. The field specification is expressed as a JSONPath expression (e.g. '{.metadata.name}').")
+	GetCmd.PersistentFlags().BoolVar(&vars.SortReverse, "reverse", false, "If present, sort the objects in descending order with --sort-by.")
 }
 
 func init() {
@@ -196,268 +190,139 @@ func init() {
 	templateprinters.AddTemplateOpenShiftHandlers(vars.TableGenerator)
 }
 
-func getNamespacedResources(resourceNamePlural string, resourceGroup string, resources map[string]struct{}) error {
-	var namespaces []string
+// getter holds the objects read by an omc get invocation until they are printed.
+type getter struct {
+	// toSort holds the objects of all the resources read, to be sorted by --sort-by.
+	toSort []unstructured.Unstructured
+	// tables holds the rows printed by handleOutput for the default, wide, csv, tsv, markdown,
+	// html and custom-columns outputs.
+	tables kindTables
+}
+
+// getResources handles the objects of the given resources, as returned by validateArgs.
+func (g *getter) getResources(resources []string) error {
+	for _, resource := range resources {
+		resourceNamePlural, resourceGroup, _, namespaced, err := KindGroupNamespaced(resource)
+		if err != nil {
+			klog.V(1).ErrorS(err, "ERROR")
+			return err
+		}
+		// namespaces and projects resources
+		// are exceptions to must-gather resources structure
+		switch {
+		case resourceNamePlural == "namespaces" || resourceNamePlural == "projects":
+			err = g.getNamespacesResources(vars.GetArgs[resourceNamePlural+"."+resourceGroup])
+		case resourceNamePlural == "podnetworkconnectivitychecks":
+			err = g.getPodNetworkConnectivityChecksResources(vars.GetArgs[resourceNamePlural+"."+resourceGroup])
+		case namespaced:
+			err = g.getNamespacedResources(resourceNamePlural, resourceGroup, vars.GetArgs[resourceNamePlural+"."+resourceGroup])
+		default:
+			err = g.getClusterScopedResources(resourceNamePlural, resourceGroup, vars.GetArgs[resourceNamePlural+"."+resourceGroup])
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return g.handleSorted()
+}
+
+// Objects returns the objects of the given resources, given as the arguments of "omc get": those
+// of the namespace in use, or of all namespaces with --all-namespaces, matching the label and
+// field selectors.
+func Objects(args []string) ([]unstructured.Unstructured, error) {
+	savedOutput, savedArgs, savedList := vars.OutputStringVar, vars.GetArgs, vars.UnstructuredList
+	defer func() {
+		vars.OutputStringVar, vars.GetArgs, vars.UnstructuredList = savedOutput, savedArgs, savedList
+	}()
+	// the objects are collected as for the json output
+	vars.OutputStringVar = "json"
+	vars.GetArgs = make(map[string]map[string]struct{})
+	vars.UnstructuredList = types.UnstructuredList{ApiVersion: "v1", Kind: "List"}
+	resources, err := validateArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	if err := (&getter{}).getResources(resources); err != nil {
+		return nil, err
+	}
+	return vars.UnstructuredList.Items, nil
+}
+
+func (g *getter) getNamespacedResources(resourceNamePlural string, resourceGroup string, resources map[string]struct{}) error {
 	if vars.AllNamespaceBoolVar {
 		vars.Namespace = ""
 		vars.ShowNamespace = true
-		_namespaces, _ := ReadDirForResources(vars.MustGatherRootPath + "/namespaces/")
-		for _, f := range _namespaces {
-			namespaces = append(namespaces, f.Name())
-		}
-	} else {
-		namespaces = append(namespaces, vars.Namespace)
 	}
-	for _, namespace := range namespaces {
-		UnstructuredItems := types.UnstructuredList{ApiVersion: "v1", Kind: "List"}
-		resourcesItemsPath := fmt.Sprintf("%s/namespaces/%s/%s/%s.yaml", vars.MustGatherRootPath, namespace, resourceGroup, resourceNamePlural)
-		_file, err := os.ReadFile(resourcesItemsPath)
-		if err == nil { // able to read <resourceplural>.yaml, which contains list of items, i.e. /namespaces/<NAMESPACE>/core/pods.yaml
-			err := yaml.Unmarshal(_file, &UnstructuredItems)
-			if err != nil { // unable to unmarshal the file, it may be empty or corrupted
-				// We handle this situation by looking for the pod in the pods directory
-				fStat, _ := os.Stat(resourcesItemsPath)
-				fSize := fStat.Size()
-				if resourceNamePlural == "pods" && fSize == 0 {
-					// tranverse the pods directory and fill in UnstructuredItems.Items
-					podsDir := fmt.Sprintf("%s/namespaces/%s/pods", vars.MustGatherRootPath, namespace)
-					pods, rErr := ReadDirForResources(podsDir)
-					if rErr != nil {
-						klog.V(3).ErrorS(err, "Failed to read resources:")
-					}
-					for _, pod := range pods {
-						podName := pod.Name()
-						podPath := fmt.Sprintf("%s/%s/%s.yaml", podsDir, podName, podName)
-						_file, err := os.ReadFile(podPath)
-						if err != nil {
-							return fmt.Errorf("error reading %s: %w", podPath, err)
-						}
-						var podItem unstructured.Unstructured
-						if err := yaml.Unmarshal(_file, &podItem); err != nil {
-							return fmt.Errorf("error unmarshaling %s: %w", podPath, err)
-						}
-						if podItem.Object != nil {
-							UnstructuredItems.Items = append(UnstructuredItems.Items, podItem)
-						}
-					}
-				} else {
-					return fmt.Errorf("error unmarshaling %s: %w", resourcesItemsPath, err)
-				}
-			}
+	// namespaces are read and decoded concurrently, but handled in order
+	// so that the output does not depend on the parallelism
+	gvr := schema.GroupVersionResource{Group: resourceGroup, Resource: resourceNamePlural}
+	return newReader().VisitNamespaced(gvr, vars.Namespace, func(_ string, items []unstructured.Unstructured) error {
+		return g.handleItems(items, resources)
+	})
+}
 
-			if vars.SortBy != "" {
-				UnstructuredItems.Items = sortResources(UnstructuredItems.Items, vars.SortBy)
-			}
-			for _, item := range UnstructuredItems.Items {
-				if len(resources) > 0 {
-					if _, ok := resources[item.GetName()]; ok {
-						if err := handleObject(item); err != nil {
-							return err
-						}
-					}
-				} else {
-					if err := handleObject(item); err != nil {
-						return err
-					}
-				}
-			}
-		} else { // the resources are customresources so, stored in a single file per resource
-			resourceDir := fmt.Sprintf("%s/namespaces/%s/%s/%s", vars.MustGatherRootPath, namespace, resourceGroup, resourceNamePlural)
-			_, err = os.Stat(resourceDir)
-			if err == nil {
-				resourcesFiles, rErr := ReadDirForResources(resourceDir)
-				if rErr != nil {
-					klog.V(3).ErrorS(err, "Failed to read resources:")
-				}
-				var sortObjects []unstructured.Unstructured
-				for _, f := range resourcesFiles {
-					if f.IsDir() {
-						fmt.Fprintf(
-							os.Stderr,
-							"error: invalid must-gather structure, yaml files are expected in path \"/namespaces/%s/%s/%s\", found directory: \"%s\"\n",
-							namespace, resourceGroup, resourceNamePlural, f.Name(),
-						)
-						continue
-					}
-					resourceYamlPath := resourceDir + "/" + f.Name()
-					_file, err := os.ReadFile(resourceYamlPath)
-					if err != nil {
-						return fmt.Errorf("error reading %s: %w", resourceYamlPath, err)
-					}
-					item := unstructured.Unstructured{}
-					if err := yaml.Unmarshal(_file, &item); err != nil {
-						return fmt.Errorf("error unmarshaling %s: %w", resourceYamlPath, err)
-					}
-					if vars.SortBy != "" {
-						sortObjects = append(sortObjects, item)
-					} else {
-						if len(resources) > 0 {
-							if _, ok := resources[item.GetName()]; ok {
-								if err := handleObject(item); err != nil {
-									return err
-								}
-							}
-						} else {
-							if err := handleObject(item); err != nil {
-								return err
-							}
-						}
-					}
-				}
+func (g *getter) getNamespacesResources(resources map[string]struct{}) error {
+	items, err := newReader().List(schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}, "", metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	return g.handleItems(items, resources)
+}
 
-				if vars.SortBy != "" {
-					sortObjects = sortResources(sortObjects, vars.SortBy)
-					for _, item := range sortObjects {
-						if len(resources) > 0 {
-							if _, ok := resources[item.GetName()]; ok {
-								if err := handleObject(item); err != nil {
-									return err
-								}
-							}
-						} else {
-							if err := handleObject(item); err != nil {
-								return err
-							}
-						}
-					}
-				}
-			}
-		}
+func (g *getter) getClusterScopedResources(resourceNamePlural string, resourceGroup string, resources map[string]struct{}) error {
+	items, err := newReader().List(schema.GroupVersionResource{Group: resourceGroup, Resource: resourceNamePlural}, "", metav1.ListOptions{})
+	if err != nil {
+		return err
 	}
-	return nil
+	return g.handleItems(items, resources)
 }
 
-func getNamespacesResources(resources map[string]struct{}) error {
-	var sortObjects []unstructured.Unstructured
-	if len(resources) > 0 {
-		for namespace := range resources {
-			resourceYamlPath := fmt.Sprintf("%s/namespaces/%s/%s.yaml", vars.MustGatherRootPath, namespace, namespace)
-			_file, err := os.ReadFile(resourceYamlPath)
-			if err == nil {
-				item := unstructured.Unstructured{}
-				if err := yaml.Unmarshal(_file, &item); err != nil {
-					return fmt.Errorf("error unmarshaling %s: %w", resourceYamlPath, err)
-				}
-				if vars.SortBy != "" {
-					sortObjects = append(sortObjects, item)
-				} else {
-					if err := handleObject(item); err != nil {
-						return err
-					}
-				}
+// newReader returns a reader for the must-gather in use.
+func newReader() *mustgather.Reader {
+	return mustgather.NewReader(vars.MustGatherRootPath, mustgather.WithParallelism(vars.Parallelism))
+}
+
+// handleItems handles the objects of a resource, limited to the given names if any, or holds
+// them until all the resources are read when they are sorted.
+func (g *getter) handleItems(items []unstructured.Unstructured, resources map[string]struct{}) error {
+	for _, item := range items {
+		if len(resources) > 0 {
+			if _, ok := resources[item.GetName()]; !ok {
+				continue
 			}
 		}
-	} else {
-		_namespaces, _ := os.ReadDir(vars.MustGatherRootPath + "/namespaces/")
-		for _, namespace := range _namespaces {
-			resourceYamlPath := fmt.Sprintf("%s/namespaces/%s/%s.yaml", vars.MustGatherRootPath, namespace.Name(), namespace.Name())
-			_file, err := os.ReadFile(resourceYamlPath)
-			if err == nil {
-				item := unstructured.Unstructured{}
-				if err := yaml.Unmarshal(_file, &item); err != nil {
-					return fmt.Errorf("error unmarshaling %s: %w", resourceYamlPath, err)
-				}
-				if vars.SortBy != "" {
-					sortObjects = append(sortObjects, item)
-				} else {
-					if err := handleObject(item); err != nil {
-						return err
-					}
-				}
-			}
+		if vars.SortBy != "" {
+			g.toSort = append(g.toSort, item)
+			continue
 		}
-	}
-	if vars.SortBy != "" {
-		sortObjects = sortResources(sortObjects, vars.SortBy)
-		for _, item := range sortObjects {
-			if err := handleObject(item); err != nil {
-				return err
-			}
+		if err := g.handleObject(item); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-func getClusterScopedResources(resourceNamePlural string, resourceGroup string, resources map[string]struct{}) error {
-	UnstructuredItems := types.UnstructuredList{ApiVersion: "v1", Kind: "List"}
-	resourcePath := fmt.Sprintf("%s/cluster-scoped-resources/%s/%s.yaml", vars.MustGatherRootPath, resourceGroup, resourceNamePlural)
-	_file, err := os.ReadFile(resourcePath)
-	if err != nil {
-		resourceDir := fmt.Sprintf("%s/cluster-scoped-resources/%s/%s", vars.MustGatherRootPath, resourceGroup, resourceNamePlural)
-		resourcesFiles, rErr := ReadDirForResources(resourceDir)
-		if rErr != nil {
-			klog.V(3).ErrorS(err, "Failed to read resources:")
-		}
-		for _, f := range resourcesFiles {
-			resourceYamlPath := resourceDir + "/" + f.Name()
-			_file, err := os.ReadFile(resourceYamlPath)
-			if err != nil {
-				return fmt.Errorf("error reading %s: %w", resourceYamlPath, err)
-			}
-			item := unstructured.Unstructured{}
-			if err := yaml.Unmarshal(_file, &item); err != nil {
-				return fmt.Errorf("error unmarshaling %s: %w", resourceYamlPath, err)
-			}
-			if item.IsList() {
-				return fmt.Errorf("file %q contains a \"List\" objectKind, while it should contain a single resource", resourceYamlPath)
-			}
-			if vars.SortBy != "" {
-				UnstructuredItems.Items = append(UnstructuredItems.Items, item)
-			} else {
-				if len(resources) > 0 {
-					if _, ok := resources[item.GetName()]; ok {
-						if err := handleObject(item); err != nil {
-							return err
-						}
-					}
-				} else {
-					if err := handleObject(item); err != nil {
-						return err
-					}
-				}
-			}
-		}
-		if vars.SortBy != "" {
-			UnstructuredItems.Items = sortResources(UnstructuredItems.Items, vars.SortBy)
-			for _, item := range UnstructuredItems.Items {
-				if len(resources) > 0 {
-					if _, ok := resources[item.GetName()]; ok {
-						if err := handleObject(item); err != nil {
-							return err
-						}
-					}
-				} else {
-					if err := handleObject(item); err != nil {
-						return err
-					}
-				}
-			}
-		}
-	} else {
-		if err := yaml.Unmarshal(_file, &UnstructuredItems); err != nil {
-			return fmt.Errorf("error unmarshaling %s: %w", resourcePath, err)
-		}
-		if vars.SortBy != "" {
-			UnstructuredItems.Items = sortResources(UnstructuredItems.Items, vars.SortBy)
-		}
-		for _, item := range UnstructuredItems.Items {
-			if len(resources) > 0 {
-				if _, ok := resources[item.GetName()]; ok {
-					if err := handleObject(item); err != nil {
-						return err
-					}
-				}
-			} else {
-				if err := handleObject(item); err != nil {
-					return err
-				}
-			}
+// handleSorted handles the objects held by handleItems, sorted across kinds and namespaces.
+func (g *getter) handleSorted() error {
+	if vars.SortBy == "" {
+		return nil
+	}
+	items := g.toSort
+	g.toSort = nil
+	if err := Sort(items, vars.SortBy, vars.SortReverse, func(item unstructured.Unstructured) map[string]interface{} {
+		return item.Object
+	}); err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := g.handleObject(item); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-func handleObject(obj unstructured.Unstructured) error {
+func (g *getter) handleObject(obj unstructured.Unstructured) error {
 	if vars.Namespace != "" && obj.GetNamespace() != "" && vars.Namespace != obj.GetNamespace() {
 		return nil
 	}
@@ -468,7 +333,13 @@ func handleObject(obj unstructured.Unstructured) error {
 	if !labelsOk {
 		return nil
 	}
-	vars.LastKind = obj.GetKind()
+	fieldsOk, err := helpers.MatchFieldsFromMap(obj.Object, vars.FieldSelectorStringVar)
+	if err != nil {
+		return fmt.Errorf("invalid field selector %q: %w", vars.FieldSelectorStringVar, err)
+	}
+	if !fieldsOk {
+		return nil
+	}
 	if vars.OutputStringVar == "yaml" || vars.OutputStringVar == "json" {
 		if !vars.ShowManagedFields {
 			obj.SetManagedFields(nil)
@@ -476,7 +347,7 @@ func handleObject(obj unstructured.Unstructured) error {
 		vars.UnstructuredList.Items = append(vars.UnstructuredList.Items, obj)
 		return nil
 	}
-	if strings.HasPrefix(vars.OutputStringVar, "jsonpath=") {
+	if strings.HasPrefix(vars.OutputStringVar, "jsonpath=") || strings.HasPrefix(vars.OutputStringVar, "go-template=") {
 		if !vars.ShowManagedFields {
 			obj.SetManagedFields(nil)
 		}
@@ -506,48 +377,46 @@ func handleObject(obj unstructured.Unstructured) error {
 			return err
 		}
 	} else {
-		_, ok := vars.KnownResources[strings.ToLower(obj.GetKind())]
-		if ok {
-			runtimeObjectType := deserializer.RawObjectToRuntimeObject(rawObject, vars.Schema)
-			if err := yaml.Unmarshal(rawObject, runtimeObjectType); err != nil {
-				klog.V(3).Info(err, err.Error())
-			}
-			objectTable, err = tablegenerator.InternalResourceTable(runtimeObjectType, &obj)
-			if err != nil {
-				klog.V(3).Info("INFO ", fmt.Sprintf("%s: %s, %s", err.Error(), obj.GetKind(), obj.GetAPIVersion()))
-				klog.V(1).ErrorS(err, err.Error())
-				return err
-			}
-		} else {
-			objectTable, err = tablegenerator.GenerateCustomResourceTable(obj)
-			if err != nil {
-				klog.V(1).ErrorS(err, err.Error())
-				return err
-			}
+		objectTable, err = ObjectTable(obj, rawObject)
+		if err != nil {
+			return err
 		}
 	}
 
-	if vars.CurrentKind == obj.GetObjectKind().GroupVersionKind().Kind {
-		vars.Table.Rows = append(vars.Table.Rows, objectTable.Rows...)
-	} else {
-		// printo la tabella dell'oggetto precedente
-		printer := cliprint.NewTablePrinter(cliprint.PrintOptions{NoHeaders: vars.NoHeaders, Wide: vars.Wide, WithNamespace: false, ShowLabels: false})
-		err = printer.PrintObj(&vars.Table, &vars.Output)
+	g.tables.add(obj.GroupVersionKind().GroupKind(), objectTable)
+	return nil
+}
+
+// ObjectTable returns the table row of an object, as printed by default, given its
+// YAML representation; built-in kinds are printed by their table handlers, custom
+// resources by the additional printer columns of their CRD.
+func ObjectTable(obj unstructured.Unstructured, rawObject []byte) (*metav1.Table, error) {
+	if _, ok := vars.KnownResources[strings.ToLower(obj.GetKind())]; !ok {
+		if _, ok := vars.AliasToCrd[strings.ToLower(obj.GetKind())+"."+strings.Split(obj.GetAPIVersion(), "/")[0]]; !ok {
+			// neither a built-in kind nor a known custom resource
+			return tablegenerator.InternalUnstructuredApiResource(obj)
+		}
+		objectTable, err := tablegenerator.GenerateCustomResourceTable(obj)
 		if err != nil {
 			klog.V(1).ErrorS(err, err.Error())
-			return err
-		}
-		if vars.CurrentKind != "" {
-			vars.Output.WriteByte('\n')
+			return nil, err
 		}
-		vars.CurrentKind = obj.GetObjectKind().GroupVersionKind().Kind
-		vars.Table = metav1.Table{ColumnDefinitions: objectTable.ColumnDefinitions, Rows: objectTable.Rows}
+		return objectTable, nil
 	}
-	return nil
+	runtimeObjectType := deserializer.RawObjectToRuntimeObject(rawObject, vars.Schema)
+	if err := yaml.Unmarshal(rawObject, runtimeObjectType); err != nil {
+		klog.V(3).Info(err, err.Error())
+	}
+	objectTable, err := tablegenerator.InternalResourceTable(runtimeObjectType, &obj)
+	if err != nil {
+		klog.V(3).Info("INFO ", fmt.Sprintf("%s: %s, %s", err.Error(), obj.GetKind(), obj.GetAPIVersion()))
+		klog.V(1).ErrorS(err, err.Error())
+		return nil, err
+	}
+	return objectTable, nil
 }
 
-func handleOutput(w io.Writer, errOut io.Writer) error {
-	printer := cliprint.NewTablePrinter(cliprint.PrintOptions{NoHeaders: vars.NoHeaders, Wide: vars.Wide, WithNamespace: false, ShowLabels: false})
+func (g *getter) handleOutput(w io.Writer, errOut io.Writer) error {
 	_resources := make([]string, 0, len(vars.GetArgs))
 	var includesClusterScoped bool
 	for resource := range vars.GetArgs {
@@ -596,6 +465,18 @@ func handleOutput(w io.Writer, errOut io.Writer) error {
 				fmt.Fprintf(errOut, "No resources %s found.\n", resources)
 			}
 		}
+	} else if strings.HasPrefix(vars.OutputStringVar, "go-template=") {
+		if len(vars.UnstructuredList.Items) > 0 {
+			if err := printGoTemplate(w, strings.TrimPrefix(vars.OutputStringVar, "go-template="), vars.UnstructuredList.Items, vars.SingleResource); err != nil {
+				return err
+			}
+		} else {
+			if vars.Namespace != "" {
+				fmt.Fprintf(errOut, "No resources %s found in %s namespace.\n", resources, vars.Namespace)
+			} else {
+				fmt.Fprintf(errOut, "No resources %s found.\n", resources)
+			}
+		}
 	} else if vars.OutputStringVar == "yaml" {
 		if vars.SingleResource && len(vars.UnstructuredList.Items) == 1 {
 			data, _ := yaml.Marshal(vars.UnstructuredList.Items[0].Object)
@@ -611,11 +492,8 @@ func handleOutput(w io.Writer, errOut io.Writer) error {
 			}
 		}
 	} else {
-		if vars.LastKind == vars.CurrentKind {
-			if err := printer.PrintObj(&vars.Table, &vars.Output); err != nil {
-				return fmt.Errorf("error printing table: %w", err)
-			}
-			vars.Table = metav1.Table{}
+		if err := g.tables.print(&vars.Output); err != nil {
+			return fmt.Errorf("error printing table: %w", err)
 		}
 		if vars.Output.Len() == 0 {
 			// never print the (default/current) namespace if at least one cluster-scoped resource is requested
@@ -631,7 +509,7 @@ func handleOutput(w io.Writer, errOut io.Writer) error {
 	return nil
 }
 
-func getPodNetworkConnectivityChecksResources(resources map[string]struct{}) error {
+func (g *getter) getPodNetworkConnectivityChecksResources(resources map[string]struct{}) error {
 	resourcesYamlPath := vars.MustGatherRootPath + "/pod_network_connectivity_check/podnetworkconnectivitychecks.yaml"
 	_file, err := os.ReadFile(resourcesYamlPath)
 	if err == nil {
@@ -642,7 +520,7 @@ func getPodNetworkConnectivityChecksResources(resources map[string]struct{}) err
 		for _, item := range UnstructuredItems.Items {
 			_, ok := resources[item.GetName()]
 			if ok || len(resources) == 0 {
-				if err := handleObject(item); err != nil {
+				if err := g.handleObject(item); err != nil {
 					return err
 				}
 			}
@@ -650,55 +528,3 @@ func getPodNetworkConnectivityChecksResources(resources map[string]struct{}) err
 	}
 	return nil
 }
-
-func sortResources(list []unstructured.Unstructured, sortBy string) []unstructured.Unstructured {
-	if sortBy == "" {
-		// no need to sort. Return original list
-		return list
-	}
-
-	// relaxed jsonpath like kubectl/oc
-	submatches := jsonRegexp.FindStringSubmatch(sortBy)
-	if submatches == nil {
-		klog.V(3).Info("Failed to identify relaxed jsonpath, skipping")
-		return list
-	}
-	if len(submatches) != 3 {
-		klog.V(3).Info("unexpected submatch list: ", submatches)
-		return list
-	}
-	if len(submatches[1]) != 0 {
-		sortBy = submatches[1]
-	} else {
-		sortBy = submatches[2]
-	}
-	sortBy = fmt.Sprintf("{.%s}", sortBy)
-
-	jpath := jsonpath.New("out")
-	jpath.AllowMissingKeys(false)
-	jpath.EnableJSONOutput(true)
-	jpath.Parse(sortBy)
-
-	var newlist []unstructured.Unstructured
-	newlist = append(newlist, list...)
-
-	slices.SortFunc(newlist, func(a, b unstructured.Unstructured) int {
-		abuf := new(bytes.Buffer)
-		bbuf := new(bytes.Buffer)
-
-		err := jpath.Execute(abuf, a.UnstructuredContent())
-		if err != nil {
-			klog.V(3).ErrorS(fmt.Errorf("field %s not found in object %s/%s", vars.SortBy, b.GetKind(), b.GetName()), "jsonpath error")
-			return 0
-		}
-		err = jpath.Execute(bbuf, b.UnstructuredContent())
-		if err != nil {
-			klog.V(3).ErrorS(fmt.Errorf("field %s not found in object %s/%s", vars.SortBy, b.GetKind(), b.GetName()), "jsonpath error")
-			return 0
-		}
-
-		return strings.Compare(abuf.String(), bbuf.String())
-	})
-
-	return newlist
-}
@@ -4,13 +4,19 @@ package get
 
 import (
 	"bytes"
+	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
 
+	"github.com/gmeghnag/omc/internal/testutil"
+	"github.com/gmeghnag/omc/pkg/index"
 	"github.com/gmeghnag/omc/types"
 	"github.com/gmeghnag/omc/vars"
 )
@@ -66,7 +72,7 @@ func TestHandleEmptyWideOutput(t *testing.T) {
 			vars.MustGatherRootPath = "../../testdata/"
 			vars.Namespace = tt.namespace
 			validateArgs(tt.rtype)
-			handleOutput(&stdout, &stderr)
+			(&getter{}).handleOutput(&stdout, &stderr)
 			if !strings.Contains(stderr.String(), tt.want) {
 				t.Errorf("Got: %v \n", stderr.String())
 				t.Errorf("Want: %v \n", tt.want)
@@ -90,7 +96,7 @@ func TestGetClusterScopedResources_ReturnsErrorOnCorruptYAML(t *testing.T) {
 	t.Cleanup(func() { vars.MustGatherRootPath = saved })
 	vars.MustGatherRootPath = root
 
-	if err := getClusterScopedResources("clusterversions", "config.openshift.io", nil); err == nil {
+	if err := (&getter{}).getClusterScopedResources("clusterversions", "config.openshift.io", nil); err == nil {
 		t.Fatalf("expected error from corrupt yaml, got nil")
 	}
 }
@@ -144,7 +150,7 @@ func TestHandleObject_ReturnsErrorOnBadCustomColumns(t *testing.T) {
 	obj.SetKind("ConfigMap")
 	obj.SetName("test")
 
-	if err := handleObject(obj); err == nil {
+	if err := (&getter{}).handleObject(obj); err == nil {
 		t.Fatalf("expected handleObject to return error for malformed custom-columns spec, got nil")
 	}
 }
@@ -192,3 +198,514 @@ items:
 		t.Fatalf("expected GetCmd.Execute to surface the CustomColumnsTable error, got nil")
 	}
 }
+
+func TestHandleObject_FieldSelector(t *testing.T) {
+	savedOutput := vars.OutputStringVar
+	savedNs := vars.Namespace
+	savedSel := vars.FieldSelectorStringVar
+	t.Cleanup(func() {
+		vars.OutputStringVar = savedOutput
+		vars.Namespace = savedNs
+		vars.FieldSelectorStringVar = savedSel
+		vars.Output.Reset()
+	})
+	vars.OutputStringVar = "name"
+	vars.Namespace = ""
+
+	newPod := func(name, phase, node string) unstructured.Unstructured {
+		obj := unstructured.Unstructured{Object: map[string]interface{}{
+			"spec":   map[string]interface{}{"nodeName": node},
+			"status": map[string]interface{}{"phase": phase},
+		}}
+		obj.SetAPIVersion("v1")
+		obj.SetKind("Pod")
+		obj.SetName(name)
+		return obj
+	}
+	newRoute := func(name, host string) unstructured.Unstructured {
+		obj := unstructured.Unstructured{Object: map[string]interface{}{
+			"spec": map[string]interface{}{"host": host, "tls": map[string]interface{}{"termination": "edge"}},
+		}}
+		obj.SetAPIVersion("route.openshift.io/v1")
+		obj.SetKind("Route")
+		obj.SetName(name)
+		return obj
+	}
+	objects := []unstructured.Unstructured{
+		newPod("running", "Running", "master-0"),
+		newPod("failed", "Failed", "master-1"),
+		newPod("pending", "Pending", ""),
+		newRoute("console", "console.apps.example.com"),
+	}
+
+	tests := []struct {
+		name     string
+		selector string
+		want     string
+		wantErr  bool
+	}{
+		{name: "empty selector", selector: "", want: "pod/running\npod/failed\npod/pending\nroute.route.openshift.io/console\n"},
+		{name: "equality", selector: "status.phase=Running", want: "pod/running\n"},
+		{name: "double equality", selector: "status.phase==Failed", want: "pod/failed\n"},
+		{name: "inequality", selector: "status.phase!=Running", want: "pod/failed\npod/pending\nroute.route.openshift.io/console\n"},
+		{name: "missing field matches empty value", selector: "spec.nodeName=", want: "pod/pending\nroute.route.openshift.io/console\n"},
+		{name: "multiple requirements", selector: "metadata.name!=failed,spec.nodeName!=master-0", want: "pod/pending\nroute.route.openshift.io/console\n"},
+		{name: "custom resource field", selector: "spec.tls.termination=edge", want: "route.route.openshift.io/console\n"},
+		{name: "invalid selector", selector: "status.phase", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vars.Output.Reset()
+			vars.FieldSelectorStringVar = tc.selector
+			g := &getter{}
+			var err error
+			for _, obj := range objects {
+				if err = g.handleObject(obj); err != nil {
+					break
+				}
+			}
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error for field selector %q, got nil", tc.selector)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := vars.Output.String(); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestObjectTable_LabelColumns(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "namespaces"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	savedPath := vars.MustGatherRootPath
+	savedLabelColumns := vars.LabelColumns
+	savedShowLabels := vars.ShowLabelsBoolVar
+	t.Cleanup(func() {
+		vars.MustGatherRootPath = savedPath
+		vars.LabelColumns = savedLabelColumns
+		vars.ShowLabelsBoolVar = savedShowLabels
+	})
+	vars.MustGatherRootPath = root
+
+	labels := map[string]string{"tier": "fe", "app": "web", "kubernetes.io/arch": "amd64"}
+	pod := unstructured.Unstructured{Object: map[string]interface{}{"status": map[string]interface{}{"phase": "Running"}}}
+	pod.SetAPIVersion("v1")
+	pod.SetKind("Pod")
+	pod.SetName("web-0")
+	pod.SetLabels(labels)
+	// a custom resource without a CRD
+	foo := unstructured.Unstructured{}
+	foo.SetAPIVersion("example.com/v1")
+	foo.SetKind("Foo")
+	foo.SetName("foo")
+	foo.SetLabels(labels)
+
+	tests := []struct {
+		name         string
+		obj          unstructured.Unstructured
+		labelColumns []string
+		showLabels   bool
+		wantColumns  []string
+		wantCells    []interface{}
+	}{
+		{name: "label columns", obj: pod, labelColumns: []string{"app", "kubernetes.io/arch", "missing"}, wantColumns: []string{"APP", "ARCH", "MISSING"}, wantCells: []interface{}{"web", "amd64", ""}},
+		{name: "show labels", obj: pod, showLabels: true, wantColumns: []string{"Labels"}, wantCells: []interface{}{"app=web,kubernetes.io/arch=amd64,tier=fe"}},
+		{name: "label columns before show labels", obj: pod, labelColumns: []string{"tier"}, showLabels: true, wantColumns: []string{"TIER", "Labels"}, wantCells: []interface{}{"fe", "app=web,kubernetes.io/arch=amd64,tier=fe"}},
+		{name: "unknown kind", obj: foo, labelColumns: []string{"app"}, showLabels: true, wantColumns: []string{"APP", "Labels"}, wantCells: []interface{}{"web", "app=web,kubernetes.io/arch=amd64,tier=fe"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vars.LabelColumns = tc.labelColumns
+			vars.ShowLabelsBoolVar = tc.showLabels
+			raw, err := yaml.Marshal(tc.obj.Object)
+			if err != nil {
+				t.Fatal(err)
+			}
+			table, err := ObjectTable(tc.obj, raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			n := len(tc.wantColumns)
+			var columns []string
+			for _, c := range table.ColumnDefinitions[len(table.ColumnDefinitions)-n:] {
+				columns = append(columns, c.Name)
+			}
+			if !reflect.DeepEqual(columns, tc.wantColumns) {
+				t.Errorf("expected last columns %v, got %v", tc.wantColumns, columns)
+			}
+			cells := table.Rows[0].Cells[len(table.Rows[0].Cells)-n:]
+			if !reflect.DeepEqual(cells, tc.wantCells) {
+				t.Errorf("expected last cells %v, got %v", tc.wantCells, cells)
+			}
+		})
+	}
+}
+
+func TestGetNamespacedResources_IndexedMatchesDisk(t *testing.T) {
+	root := testutil.MustGather(t, map[string]string{
+		"namespaces/ns1/core/configmaps.yaml": `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: b
+    namespace: ns1
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: a
+    namespace: ns1
+`,
+		"namespaces/ns2/core/configmaps.yaml": `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: c
+    namespace: ns2
+`,
+		"namespaces/ns1/route.openshift.io/routes/web.yaml": `apiVersion: route.openshift.io/v1
+kind: Route
+metadata:
+  name: web
+  namespace: ns1
+`,
+	})
+
+	savedPath := vars.MustGatherRootPath
+	savedOutput := vars.OutputStringVar
+	savedNs := vars.Namespace
+	savedAll := vars.AllNamespaceBoolVar
+	savedSortBy := vars.SortBy
+	t.Cleanup(func() {
+		vars.MustGatherRootPath = savedPath
+		vars.OutputStringVar = savedOutput
+		vars.Namespace = savedNs
+		vars.AllNamespaceBoolVar = savedAll
+		vars.SortBy = savedSortBy
+		vars.Output.Reset()
+		_ = index.Remove(root)
+	})
+	vars.MustGatherRootPath = root
+	vars.OutputStringVar = "name"
+
+	run := func() string {
+		t.Helper()
+		vars.Output.Reset()
+		vars.Namespace = ""
+		vars.AllNamespaceBoolVar = true
+		vars.SortBy = "{.metadata.name}"
+		g := &getter{}
+		if err := g.getNamespacedResources("configmaps", "core", nil); err != nil {
+			t.Fatal(err)
+		}
+		if err := g.handleSorted(); err != nil {
+			t.Fatal(err)
+		}
+		vars.SortBy = ""
+		if err := g.getNamespacedResources("routes", "route.openshift.io", map[string]struct{}{"web": {}}); err != nil {
+			t.Fatal(err)
+		}
+		return vars.Output.String()
+	}
+	fromDisk := run()
+	if want := "configmap/a\nconfigmap/b\nconfigmap/c\nroute.route.openshift.io/web\n"; fromDisk != want {
+		t.Fatalf("expected %q, got %q", want, fromDisk)
+	}
+	if err := index.Build(root); err != nil {
+		t.Fatal(err)
+	}
+	if index.Lookup(root) == nil {
+		t.Fatalf("expected the must-gather to be indexed")
+	}
+	if fromIndex := run(); fromIndex != fromDisk {
+		t.Errorf("expected the indexed output to match the output read from disk %q, got %q", fromDisk, fromIndex)
+	}
+}
+
+func TestGetNamespacedResources_ParallelismKeepsOrder(t *testing.T) {
+	files := map[string]string{}
+	for i := 0; i < 20; i++ {
+		namespace := fmt.Sprintf("ns%02d", i)
+		files["namespaces/"+namespace+"/core/configmaps.yaml"] = fmt.Sprintf(`apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: %[1]s-b
+    namespace: %[1]s
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: %[1]s-a
+    namespace: %[1]s
+`, namespace)
+	}
+	root := testutil.MustGather(t, files)
+
+	savedPath := vars.MustGatherRootPath
+	savedOutput := vars.OutputStringVar
+	savedNs := vars.Namespace
+	savedAll := vars.AllNamespaceBoolVar
+	savedParallelism := vars.Parallelism
+	t.Cleanup(func() {
+		vars.MustGatherRootPath = savedPath
+		vars.OutputStringVar = savedOutput
+		vars.Namespace = savedNs
+		vars.AllNamespaceBoolVar = savedAll
+		vars.Parallelism = savedParallelism
+		vars.Output.Reset()
+	})
+	vars.MustGatherRootPath = root
+	vars.OutputStringVar = "name"
+
+	run := func(parallelism int) (string, error) {
+		vars.Output.Reset()
+		vars.Namespace = ""
+		vars.AllNamespaceBoolVar = true
+		vars.Parallelism = parallelism
+		err := (&getter{}).getNamespacedResources("configmaps", "core", nil)
+		return vars.Output.String(), err
+	}
+	serial, err := run(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(serial, "configmap/ns00-b\nconfigmap/ns00-a\nconfigmap/ns01-b\n") {
+		t.Fatalf("unexpected serial output %q", serial)
+	}
+	for _, parallelism := range []int{0, 4, 50} {
+		got, err := run(parallelism)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != serial {
+			t.Errorf("parallelism %d: expected %q, got %q", parallelism, serial, got)
+		}
+	}
+
+	// the error of a namespace is returned after the preceding namespaces are handled
+	if err := os.WriteFile(filepath.Join(root, "namespaces", "ns05", "core", "configmaps.yaml"), []byte("items: [\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := run(4)
+	if err == nil || !strings.Contains(err.Error(), "ns05") {
+		t.Fatalf("expected an error for namespace ns05, got %v", err)
+	}
+	if !strings.HasSuffix(got, "configmap/ns04-a\n") {
+		t.Errorf("expected the namespaces preceding ns05 to be handled, got %q", got)
+	}
+}
+
+func TestValidateArgs(t *testing.T) {
+	// a CRD that is only stored locally
+	localCrd := `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: bazs.example.com
+spec:
+  group: example.com
+  names:
+    kind: Baz
+    plural: bazs
+    singular: baz
+    categories:
+    - Example
+  scope: Namespaced
+`
+	root := testutil.MustGather(t, map[string]string{
+		"cluster-scoped-resources/apiextensions.k8s.io/customresourcedefinitions/foos.example.com.yaml": `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: foos.example.com
+spec:
+  group: example.com
+  names:
+    kind: Foo
+    plural: foos
+    singular: foo
+    categories:
+    - all
+  scope: Namespaced
+`,
+		"cluster-scoped-resources/apiextensions.k8s.io/customresourcedefinitions/bars.example.com.yaml": `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: bars.example.com
+spec:
+  group: example.com
+  names:
+    kind: Bar
+    plural: bars
+    singular: bar
+    categories:
+    - example
+  scope: Namespaced
+`,
+	})
+	testutil.WriteFiles(t, os.Getenv("HOME"), map[string]string{".omc/customresourcedefinitions/bazs.example.com.yaml": localCrd})
+	savedPath := vars.MustGatherRootPath
+	savedArgs := vars.GetArgs
+	savedShowKind := vars.ShowKind
+	savedSingle := vars.SingleResource
+	t.Cleanup(func() {
+		vars.MustGatherRootPath = savedPath
+		vars.GetArgs = savedArgs
+		vars.ShowKind = savedShowKind
+		vars.SingleResource = savedSingle
+	})
+	vars.MustGatherRootPath = root
+
+	tests := []struct {
+		name         string
+		args         []string
+		want         []string
+		wantShowKind bool
+		wantNames    map[string][]string
+		wantErr      string
+	}{
+		{name: "single resource", args: []string{"svc"}, want: []string{"services.core"}},
+		{name: "resources in the given order", args: []string{"svc,pods,deploy,svc"}, want: []string{"services.core", "pods.core", "deployments.apps"}, wantShowKind: true},
+		{name: "all", args: []string{"all"}, want: append(append([]string{}, allResources...), "foos.example.com"), wantShowKind: true},
+		{name: "all in a list", args: []string{"cm,all"}, want: append(append([]string{"configmaps.core"}, allResources...), "foos.example.com"), wantShowKind: true},
+		{name: "category", args: []string{"example"}, want: []string{"bars.example.com", "bazs.example.com"}, wantShowKind: true},
+		{name: "category and resources", args: []string{"bar,example,foo"}, want: []string{"bars.example.com", "bazs.example.com", "foos.example.com"}, wantShowKind: true},
+		{name: "unknown", args: []string{"pods,unknown"}, wantErr: `resource type "unknown" not known.`},
+		{name: "resource/name", args: []string{"deploy/web", "po/a", "deployment.apps/db"}, want: []string{"deployments.apps", "pods.core"}, wantShowKind: true, wantNames: map[string][]string{"deployments.apps": {"db", "web"}, "pods.core": {"a"}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vars.GetArgs = make(map[string]map[string]struct{})
+			vars.ShowKind = false
+			got, err := validateArgs(tc.args)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("expected error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected resources %v, got %v", tc.want, got)
+			}
+			if vars.ShowKind != tc.wantShowKind {
+				t.Errorf("expected ShowKind %v, got %v", tc.wantShowKind, vars.ShowKind)
+			}
+			for resource, names := range tc.wantNames {
+				var gotNames []string
+				for name := range vars.GetArgs[resource] {
+					gotNames = append(gotNames, name)
+				}
+				sort.Strings(gotNames)
+				if !reflect.DeepEqual(gotNames, names) {
+					t.Errorf("expected names %v for %s, got %v", names, resource, gotNames)
+				}
+			}
+		})
+	}
+}
+
+func TestObjects(t *testing.T) {
+	root := testutil.MustGather(t, map[string]string{
+		"namespaces/ns1/core/configmaps.yaml": `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: a
+    namespace: ns1
+    labels:
+      app: web
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: b
+    namespace: ns1
+`,
+		"namespaces/ns2/core/configmaps.yaml": `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: c
+    namespace: ns2
+    labels:
+      app: web
+`,
+		"namespaces/ns2/core/services.yaml": `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: web
+    namespace: ns2
+    labels:
+      app: web
+`,
+	})
+	savedPath := vars.MustGatherRootPath
+	savedOutput := vars.OutputStringVar
+	savedNs := vars.Namespace
+	savedAll := vars.AllNamespaceBoolVar
+	savedSelector := vars.LabelSelectorStringVar
+	t.Cleanup(func() {
+		vars.MustGatherRootPath = savedPath
+		vars.OutputStringVar = savedOutput
+		vars.Namespace = savedNs
+		vars.AllNamespaceBoolVar = savedAll
+		vars.LabelSelectorStringVar = savedSelector
+	})
+	vars.MustGatherRootPath = root
+
+	tests := []struct {
+		name          string
+		args          []string
+		namespace     string
+		allNamespaces bool
+		selector      string
+		want          []string
+	}{
+		{name: "namespace", args: []string{"cm"}, namespace: "ns1", want: []string{"ns1/a", "ns1/b"}},
+		{name: "all namespaces", args: []string{"configmaps"}, allNamespaces: true, want: []string{"ns1/a", "ns1/b", "ns2/c"}},
+		{name: "label selector across kinds", args: []string{"cm,svc"}, allNamespaces: true, selector: "app=web", want: []string{"ns1/a", "ns2/c", "ns2/web"}},
+		{name: "names", args: []string{"cm/b", "svc/web"}, namespace: "ns2", want: []string{"ns2/web"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vars.OutputStringVar = "wide"
+			vars.Namespace = tc.namespace
+			vars.AllNamespaceBoolVar = tc.allNamespaces
+			vars.LabelSelectorStringVar = tc.selector
+			objects, err := Objects(tc.args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, obj := range objects {
+				got = append(got, obj.GetNamespace()+"/"+obj.GetName())
+			}
+			if strings.Join(got, " ") != strings.Join(tc.want, " ") {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+			if vars.OutputStringVar != "wide" {
+				t.Errorf("expected the output format to be restored, got %q", vars.OutputStringVar)
+			}
+		})
+	}
+}
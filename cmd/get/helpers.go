@@ -8,16 +8,34 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/gmeghnag/omc/pkg/mustgather"
 	"github.com/gmeghnag/omc/vars"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/yaml"
 )
 
-func validateArgs(args []string) error {
-	if len(args) == 1 && args[0] == "all" {
-		args = []string{"pods.core,services.core,daemonsets.apps,deployments.apps,replicasets.apps,statefulsets.apps,replicationcontrollers.core,deploymentconfigs.apps.openshift.io,builds.build.openshift.io,buildconfigs.build.openshift.io,jobs.batch,cronjobs.batch,routes.route.openshift.io,ingresses.networking.k8s.io,"}
+// validateArgs fills vars.GetArgs with the resources, and the names of the objects if any, given as
+// arguments, and returns the resources in the order they were given so that they are printed in
+// that order. Categories, such as "all" or those listed by CRDs, are expanded to the resources
+// they include.
+func validateArgs(args []string) ([]string, error) {
+	var resources []string
+	addResource := func(resourceType string) (string, error) {
+		resourceNamePlural, resourceGroup, _, _, err := KindGroupNamespaced(resourceType)
+		if err != nil {
+			return "", fmt.Errorf("resource type \"%s\" not known.", resourceType)
+		}
+		resource := resourceNamePlural + "." + resourceGroup
+		if _, ok := vars.GetArgs[resource]; !ok {
+			vars.GetArgs[resource] = make(map[string]struct{})
+			resources = append(resources, resource)
+		}
+		return resource, nil
 	}
 	var _args []string
 	for _, arg := range args {
@@ -25,81 +43,62 @@ func validateArgs(args []string) error {
 	}
 	args = _args
 	if len(args) == 1 && !strings.Contains(args[0], "/") {
-		if strings.Contains(args[0], ",") {
-			vars.ShowKind = true
-			resourcesTypes := strings.Split(strings.TrimPrefix(strings.TrimSuffix(args[0], ","), ","), ",")
-			for _, resourceType := range resourcesTypes {
-				resourceNamePlural, resourceGroup, _, _, err := KindGroupNamespaced(resourceType)
-				if err == nil {
-					if !strings.Contains(resourceType, ".") {
-						vars.GetArgs[resourceNamePlural+"."+resourceGroup] = make(map[string]struct{})
-					} else {
-						vars.GetArgs[resourceType] = make(map[string]struct{})
-					}
-				} else {
-					return fmt.Errorf("resource type \"%s\" not known.", resourceType)
+		resourcesTypes := strings.Split(strings.Trim(args[0], ","), ",")
+		for _, resourceType := range resourcesTypes {
+			if resourceType != "all" {
+				if _, err := addResource(resourceType); err == nil {
+					continue
 				}
-
 			}
-		} else {
-			resourceType := args[0]
-			resourceNamePlural, resourceGroup, _, _, err := KindGroupNamespaced(resourceType)
-			if err == nil {
-				if !strings.Contains(resourceType, ".") {
-					vars.GetArgs[resourceNamePlural+"."+resourceGroup] = make(map[string]struct{})
-				} else {
-					vars.GetArgs[resourceType] = make(map[string]struct{})
+			// as for kubectl, a category expands to the resources it includes
+			members := categoryResources(resourceType)
+			if len(members) == 0 {
+				return nil, fmt.Errorf("resource type \"%s\" not known.", resourceType)
+			}
+			vars.ShowKind = true
+			for _, resource := range members {
+				if _, err := addResource(resource); err != nil {
+					klog.V(3).ErrorS(err, "Skipping resource of category", "category", resourceType)
 				}
-			} else {
-				return fmt.Errorf("resource type \"%s\" not known.", resourceType)
 			}
 		}
+		if len(resourcesTypes) > 1 {
+			vars.ShowKind = true
+		}
 	} else if len(args) > 0 && strings.Contains(args[0], "/") {
 		if len(args) == 1 {
 			vars.SingleResource = true
 		}
 		for _, arg := range args {
-			if strings.Contains(arg, "/") {
-				resource := strings.Split(arg, "/")
-				resourceType, resourceName := resource[0], resource[1]
-				resourceNamePlural, resourceGroup, _, _, err := KindGroupNamespaced(resourceType)
-				if err == nil {
-					_, ok := vars.GetArgs[resourceNamePlural+"."+resourceGroup]
-					if !ok {
-						vars.GetArgs[resourceNamePlural+"."+resourceGroup] = make(map[string]struct{})
-						vars.GetArgs[resourceNamePlural+"."+resourceGroup][resourceName] = struct{}{}
-					} else {
-						vars.GetArgs[resourceNamePlural+"."+resourceGroup][resourceName] = struct{}{}
-					}
-				} else {
-					return fmt.Errorf("resource type \"%s\" not known.", resourceType)
-				}
-			} else {
-				return fmt.Errorf("there is no need to specify a resource type as a separate argument when passing arguments in resource/name form (e.g. 'omc get resource/<resource_name>' instead of 'omc get resource resource/<resource_name>'")
+			if !strings.Contains(arg, "/") {
+				return nil, fmt.Errorf("there is no need to specify a resource type as a separate argument when passing arguments in resource/name form (e.g. 'omc get resource/<resource_name>' instead of 'omc get resource resource/<resource_name>'")
 			}
+			resourceType, resourceName, _ := strings.Cut(arg, "/")
+			resource, err := addResource(resourceType)
+			if err != nil {
+				return nil, err
+			}
+			vars.GetArgs[resource][resourceName] = struct{}{}
 		}
 		if len(vars.GetArgs) > 1 {
 			vars.ShowKind = true
 		}
 	} else if len(args) > 1 && !strings.Contains(args[0], "/") {
-		resourceType := args[0]
-		resourceNamePlural, resourceGroup, _, _, err := KindGroupNamespaced(resourceType)
-		if err == nil {
-			vars.GetArgs[resourceNamePlural+"."+resourceGroup] = make(map[string]struct{})
-		} else {
-			return fmt.Errorf("resource type \"%s\" not known.", resourceType)
+		resource, err := addResource(args[0])
+		if err != nil {
+			return nil, err
 		}
 		if len(args[0:]) == 2 {
 			vars.SingleResource = true
 		}
 		for _, resourceName := range args[1:] {
 			if strings.Contains(resourceName, "/") {
-				return fmt.Errorf("there is no need to specify a resource type as a separate argument when passing arguments in resource/name form (e.g. 'omc get resource/<resource_name>' instead of 'omc get resource resource/<resource_name>'")
+				return nil, fmt.Errorf("there is no need to specify a resource type as a separate argument when passing arguments in resource/name form (e.g. 'omc get resource/<resource_name>' instead of 'omc get resource resource/<resource_name>'")
 			}
-			vars.GetArgs[resourceNamePlural+"."+resourceGroup][resourceName] = struct{}{}
+			vars.GetArgs[resource][resourceName] = struct{}{}
 		}
 	}
-	return nil
+	return resources, nil
 }
 
 func KindGroupNamespaced(alias string) (string, string, string, bool, error) {
@@ -293,3 +292,45 @@ func readDirForResources(in fs.FS) ([]os.DirEntry, error) {
 	}
 	return resources, err
 }
+
+// CustomResourceDefinitions returns the CRDs of the must-gather, and those of
+// ~/.omc/customresourcedefinitions that the must-gather does not define.
+func CustomResourceDefinitions(reader *mustgather.Reader) []apiextensionsv1.CustomResourceDefinition {
+	var crds []apiextensionsv1.CustomResourceDefinition
+	defined := make(map[string]struct{})
+	items, err := reader.List(schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}, "", metav1.ListOptions{})
+	if err != nil {
+		klog.V(1).ErrorS(err, "Unable to read the customresourcedefinitions of the must-gather")
+	}
+	for _, item := range items {
+		var crd apiextensionsv1.CustomResourceDefinition
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &crd); err != nil {
+			klog.V(3).ErrorS(err, "Skipping customresourcedefinition", "name", item.GetName())
+			continue
+		}
+		crds = append(crds, crd)
+		defined[crd.Spec.Names.Plural+"."+crd.Spec.Group] = struct{}{}
+	}
+	home, _ := os.UserHomeDir()
+	omcCrdsPath := filepath.Join(home, ".omc", "customresourcedefinitions")
+	entries, _ := os.ReadDir(omcCrdsPath)
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".yaml" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(omcCrdsPath, e.Name()))
+		if err != nil {
+			continue
+		}
+		var crd apiextensionsv1.CustomResourceDefinition
+		if err := yaml.Unmarshal(data, &crd); err != nil || crd.Spec.Names.Plural == "" {
+			continue
+		}
+		if _, ok := defined[crd.Spec.Names.Plural+"."+crd.Spec.Group]; ok {
+			continue
+		}
+		crds = append(crds, crd)
+		defined[crd.Spec.Names.Plural+"."+crd.Spec.Group] = struct{}{}
+	}
+	return crds
+}
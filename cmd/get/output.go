@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package get
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	cliprint "k8s.io/cli-runtime/pkg/printers"
+)
+
+// resolveOutputFormat returns the output format to print with, where the templates and custom columns
+// given in a file are read inline; as for kubectl the template, or the path of the file, may be given
+// by the --template flag, and "template" and "templatefile" are aliases of the go-template formats.
+func resolveOutputFormat(output string, template string) (string, error) {
+	format, value, hasValue := strings.Cut(output, "=")
+	if !hasValue {
+		value = template
+	}
+	switch format {
+	case "go-template", "template", "jsonpath":
+		if format == "template" {
+			format = "go-template"
+		}
+		if value == "" {
+			return "", fmt.Errorf("template format specified but no template given")
+		}
+		return format + "=" + value, nil
+	case "go-template-file", "templatefile", "jsonpath-file", "custom-columns-file":
+		if value == "" {
+			return "", fmt.Errorf("%s format specified but no file given", format)
+		}
+		data, err := os.ReadFile(value)
+		if err != nil {
+			return "", fmt.Errorf("error reading template %s: %w", value, err)
+		}
+		switch format {
+		case "jsonpath-file":
+			return "jsonpath=" + strings.TrimSpace(string(data)), nil
+		case "custom-columns-file":
+			columns, err := customColumnsFromFile(data)
+			if err != nil {
+				return "", fmt.Errorf("error reading custom columns from %s: %w", value, err)
+			}
+			return "custom-columns=" + columns, nil
+		default:
+			return "go-template=" + string(data), nil
+		}
+	}
+	return output, nil
+}
+
+// customColumnsFromFile converts a custom columns file, whose first line holds the column headers and
+// second line their field specs, e.g.
+//
+//	NAME          RSRC
+//	metadata.name metadata.resourceVersion
+//
+// to the "NAME:metadata.name,RSRC:metadata.resourceVersion" form of -o custom-columns.
+func customColumnsFromFile(data []byte) (string, error) {
+	var lines [][]string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() && len(lines) < 2 {
+		if fields := strings.Fields(scanner.Text()); len(fields) > 0 {
+			lines = append(lines, fields)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	if len(lines) != 2 {
+		return "", fmt.Errorf("expected a line of headers and a line of field specs")
+	}
+	headers, specs := lines[0], lines[1]
+	if len(headers) != len(specs) {
+		return "", fmt.Errorf("expected %d field specs, found %d", len(headers), len(specs))
+	}
+	columns := make([]string, len(headers))
+	for i := range headers {
+		spec := strings.TrimSuffix(strings.TrimPrefix(specs[i], "{"), "}")
+		columns[i] = headers[i] + ":" + spec
+	}
+	return strings.Join(columns, ","), nil
+}
+
+// printGoTemplate executes a go template with the functions provided by kubectl over a single
+// object, or over a List holding the given objects.
+func printGoTemplate(w io.Writer, template string, items []unstructured.Unstructured, single bool) error {
+	printer, err := cliprint.NewGoTemplatePrinter([]byte(template))
+	if err != nil {
+		return fmt.Errorf("error parsing template %s, %w", template, err)
+	}
+	printer.AllowMissingKeys(true)
+	var obj runtime.Object
+	if single && len(items) == 1 {
+		obj = &items[0]
+	} else {
+		list := &unstructured.UnstructuredList{Items: items}
+		list.SetAPIVersion("v1")
+		list.SetKind("List")
+		obj = list
+	}
+	return printer.PrintObj(obj, w)
+}
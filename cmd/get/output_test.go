@@ -0,0 +1,148 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package get
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/gmeghnag/omc/types"
+	"github.com/gmeghnag/omc/vars"
+)
+
+func TestResolveOutputFormat(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"template.tmpl":      `{{range .items}}{{.metadata.name}}{{"\n"}}{{end}}`,
+		"jsonpath.txt":       "{.metadata.name}\n",
+		"columns.txt":        "NAME          RSRC\nmetadata.name {.metadata.resourceVersion}\n",
+		"bad-columns.txt":    "NAME RSRC\nmetadata.name\n",
+		"single-columns.txt": "NAME\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		name     string
+		output   string
+		template string
+		want     string
+		wantErr  string
+	}{
+		{name: "not a template", output: "json", template: "{{.kind}}", want: "json"},
+		{name: "go-template", output: "go-template={{.kind}}", want: "go-template={{.kind}}"},
+		{name: "template alias", output: "template={{.kind}}", want: "go-template={{.kind}}"},
+		{name: "go-template flag", output: "go-template", template: "{{.kind}}", want: "go-template={{.kind}}"},
+		{name: "jsonpath flag", output: "jsonpath", template: "{.kind}", want: "jsonpath={.kind}"},
+		{name: "missing template", output: "go-template", wantErr: "template format specified but no template given"},
+		{name: "go-template-file", output: "go-template-file=" + filepath.Join(dir, "template.tmpl"), want: "go-template=" + files["template.tmpl"]},
+		{name: "templatefile flag", output: "templatefile", template: filepath.Join(dir, "template.tmpl"), want: "go-template=" + files["template.tmpl"]},
+		{name: "jsonpath-file", output: "jsonpath-file=" + filepath.Join(dir, "jsonpath.txt"), want: "jsonpath={.metadata.name}"},
+		{name: "custom-columns-file", output: "custom-columns-file=" + filepath.Join(dir, "columns.txt"), want: "custom-columns=NAME:metadata.name,RSRC:.metadata.resourceVersion"},
+		{name: "custom-columns-file without specs", output: "custom-columns-file=" + filepath.Join(dir, "single-columns.txt"), wantErr: "expected a line of headers and a line of field specs"},
+		{name: "custom-columns-file with missing specs", output: "custom-columns-file=" + filepath.Join(dir, "bad-columns.txt"), wantErr: "expected 2 field specs, found 1"},
+		{name: "missing file", output: "go-template-file=" + filepath.Join(dir, "missing"), wantErr: "error reading template"},
+		{name: "no file", output: "jsonpath-file", wantErr: "jsonpath-file format specified but no file given"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := resolveOutputFormat(tc.output, tc.template)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestHandleOutput_GoTemplate(t *testing.T) {
+	savedOutput := vars.OutputStringVar
+	savedList := vars.UnstructuredList
+	savedSingle := vars.SingleResource
+	savedNs := vars.Namespace
+	t.Cleanup(func() {
+		vars.OutputStringVar = savedOutput
+		vars.UnstructuredList = savedList
+		vars.SingleResource = savedSingle
+		vars.Namespace = savedNs
+		vars.GetArgs = make(map[string]map[string]struct{})
+	})
+	vars.Namespace = "ns1"
+	vars.GetArgs = map[string]map[string]struct{}{"secrets": {}}
+
+	newSecret := func(name string, data map[string]interface{}) unstructured.Unstructured {
+		return unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]interface{}{"name": name, "namespace": "ns1"},
+			"data":       data,
+		}}
+	}
+	items := []unstructured.Unstructured{
+		newSecret("s1", map[string]interface{}{"password": "c2VjcmV0"}),
+		newSecret("s2", map[string]interface{}{}),
+	}
+	tests := []struct {
+		name    string
+		output  string
+		single  bool
+		items   []unstructured.Unstructured
+		want    string
+		wantErr string
+	}{
+		{name: "list", output: `go-template={{.kind}}:{{range .items}} {{.metadata.name}}{{end}}`, items: items, want: "List: s1 s2"},
+		{name: "single object", output: `go-template={{.metadata.name}}`, single: true, items: items[:1], want: "s1"},
+		{name: "base64decode", output: `go-template={{base64decode .data.password}}`, single: true, items: items[:1], want: "secret"},
+		{name: "exists", output: `go-template={{range .items}}{{if exists . "data" "password"}}{{.metadata.name}}{{end}}{{end}}`, items: items, want: "s1"},
+		{name: "missing keys", output: `go-template={{range .items}}[{{.data.password}}]{{end}}`, items: items, want: "[c2VjcmV0][<no value>]"},
+		{name: "invalid template", output: `go-template={{.kind`, items: items, wantErr: "error parsing template"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			vars.OutputStringVar = tc.output
+			vars.SingleResource = tc.single
+			vars.UnstructuredList = types.UnstructuredList{ApiVersion: "v1", Kind: "List", Items: tc.items}
+			err := (&getter{}).handleOutput(&stdout, &stderr)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stdout.String() != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, stdout.String())
+			}
+		})
+	}
+
+	t.Run("no resources", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		vars.OutputStringVar = "go-template={{.kind}}"
+		vars.UnstructuredList = types.UnstructuredList{}
+		if err := (&getter{}).handleOutput(&stdout, &stderr); err != nil {
+			t.Fatal(err)
+		}
+		if want := "No resources secrets found in ns1 namespace.\n"; stderr.String() != want {
+			t.Errorf("expected %q, got %q", want, stderr.String())
+		}
+	})
+}
@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package get
+
+import (
+	"io"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	cliprint "k8s.io/cli-runtime/pkg/printers"
+
+	"github.com/gmeghnag/omc/pkg/tableprinter"
+	"github.com/gmeghnag/omc/vars"
+)
+
+// kindTables holds the table rows of the objects to print, grouped by kind in the order the kinds
+// are first seen, so that each kind is printed as a single table even when kinds interleave, e.g.
+// across namespaces or when a resource is requested twice.
+type kindTables struct {
+	kinds  []schema.GroupKind
+	tables map[schema.GroupKind]*metav1.Table
+}
+
+// add appends the rows of an object table to the table of its kind.
+func (k *kindTables) add(kind schema.GroupKind, table *metav1.Table) {
+	if k.tables == nil {
+		k.tables = make(map[schema.GroupKind]*metav1.Table)
+	}
+	if t, ok := k.tables[kind]; ok {
+		t.Rows = append(t.Rows, table.Rows...)
+		return
+	}
+	k.kinds = append(k.kinds, kind)
+	k.tables[kind] = &metav1.Table{ColumnDefinitions: table.ColumnDefinitions, Rows: table.Rows}
+}
+
+// print prints a table for each kind, separated by an empty line, and empties the tables. The
+// csv and tsv outputs print a single table, with a KIND column when there are several kinds.
+func (k *kindTables) print(w io.Writer) error {
+	defer k.reset()
+	if tableprinter.IsDelimited(vars.OutputStringVar) && len(k.kinds) > 1 {
+		options := tableprinter.Options{Output: vars.OutputStringVar, NoHeaders: vars.NoHeaders}
+		names := make([]string, len(k.kinds))
+		tables := make([]*tableprinter.Table, len(k.kinds))
+		for i, kind := range k.kinds {
+			names[i] = kind.String()
+			tables[i] = tableprinter.FromMetaTable(options, k.tables[kind], vars.Wide)
+		}
+		return tableprinter.Concat(options, "KIND", names, tables).Print(w)
+	}
+	for i, kind := range k.kinds {
+		if i > 0 {
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+		}
+		if tableprinter.IsDelimited(vars.OutputStringVar) || tableprinter.IsDocument(vars.OutputStringVar) {
+			options := tableprinter.Options{Output: vars.OutputStringVar, NoHeaders: vars.NoHeaders}
+			if err := tableprinter.FromMetaTable(options, k.tables[kind], vars.Wide).Print(w); err != nil {
+				return err
+			}
+			continue
+		}
+		// a printer is created for each table, otherwise the headers would only be printed once
+		printer := cliprint.NewTablePrinter(cliprint.PrintOptions{NoHeaders: vars.NoHeaders, Wide: vars.Wide})
+		if err := printer.PrintObj(k.tables[kind], w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k *kindTables) reset() {
+	k.kinds = nil
+	k.tables = nil
+}
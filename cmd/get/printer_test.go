@@ -0,0 +1,117 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package get
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/gmeghnag/omc/vars"
+)
+
+func TestHandleOutput_GroupsKinds(t *testing.T) {
+	root := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(root, "namespaces"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	savedPath := vars.MustGatherRootPath
+	savedOutput := vars.OutputStringVar
+	savedNs := vars.Namespace
+	savedShowKind := vars.ShowKind
+	savedShowNamespace := vars.ShowNamespace
+	t.Cleanup(func() {
+		vars.MustGatherRootPath = savedPath
+		vars.OutputStringVar = savedOutput
+		vars.Namespace = savedNs
+		vars.ShowKind = savedShowKind
+		vars.ShowNamespace = savedShowNamespace
+		vars.Output.Reset()
+	})
+	vars.MustGatherRootPath = root
+	vars.OutputStringVar = ""
+	vars.Namespace = ""
+
+	newObject := func(apiVersion, kind, namespace, name string) unstructured.Unstructured {
+		obj := unstructured.Unstructured{Object: map[string]interface{}{}}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		return obj
+	}
+	// the objects of the namespaces are handled in turn, so kinds interleave
+	objects := []unstructured.Unstructured{
+		newObject("v1", "ConfigMap", "ns1", "a"),
+		newObject("apps/v1", "Deployment", "ns1", "web"),
+		newObject("v1", "ConfigMap", "ns2", "b"),
+		newObject("apps/v1", "Deployment", "ns2", "db"),
+	}
+
+	tests := []struct {
+		name          string
+		output        string
+		showKind      bool
+		showNamespace bool
+		want          string
+	}{
+		{
+			name: "grouped by kind",
+			want: `NAME   DATA   AGE
+a      0      <unknown>
+b      0      <unknown>
+
+NAME   READY   UP-TO-DATE   AVAILABLE   AGE
+web    0/0     0            0           <unknown>
+db     0/0     0            0           <unknown>
+`,
+		},
+		{
+			name:          "kind and group prefix",
+			showKind:      true,
+			showNamespace: true,
+			want: `NAMESPACE   NAME          DATA   AGE
+ns1         configmap/a   0      <unknown>
+ns2         configmap/b   0      <unknown>
+
+NAMESPACE   NAME                  READY   UP-TO-DATE   AVAILABLE   AGE
+ns1         deployment.apps/web   0/0     0            0           <unknown>
+ns2         deployment.apps/db    0/0     0            0           <unknown>
+`,
+		},
+		{
+			name:   "csv of several kinds",
+			output: "csv",
+			want: `KIND,NAME,DATA,AGE,READY,UP-TO-DATE,AVAILABLE
+ConfigMap,a,0,<unknown>,,,
+ConfigMap,b,0,<unknown>,,,
+Deployment.apps,web,,<unknown>,0/0,0,0
+Deployment.apps,db,,<unknown>,0/0,0,0
+`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vars.OutputStringVar = tc.output
+			vars.ShowKind = tc.showKind
+			vars.ShowNamespace = tc.showNamespace
+			g := &getter{}
+			for _, obj := range objects {
+				if err := g.handleObject(obj); err != nil {
+					t.Fatal(err)
+				}
+			}
+			var stdout, stderr bytes.Buffer
+			if err := g.handleOutput(&stdout, &stderr); err != nil {
+				t.Fatal(err)
+			}
+			vars.Output.Reset()
+			if stdout.String() != tc.want {
+				t.Errorf("expected:\n%s\ngot:\n%s", tc.want, stdout.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package get
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Sort sorts items by the value of a field, given as for kubectl --sort-by as a relaxed jsonpath
+// ({.metadata.name}, .metadata.name or metadata.name), of the object returned for each item.
+// The values are compared by type: numbers numerically, RFC3339 times chronologically,
+// quantities (e.g. 500m, 2Gi, 10) by amount and other strings alphabetically. The items missing
+// the field are sorted last, in their order, even when reversed.
+func Sort[T any](items []T, field string, reverse bool, object func(T) map[string]interface{}) error {
+	submatches := jsonRegexp.FindStringSubmatch(field)
+	if submatches == nil {
+		return fmt.Errorf("invalid --sort-by %q: expected a field specification as {.metadata.name}", field)
+	}
+	path := submatches[1]
+	if path == "" {
+		path = submatches[2]
+	}
+	jpath := jsonpath.New("sort-by").AllowMissingKeys(true)
+	if err := jpath.Parse(fmt.Sprintf("{.%s}", path)); err != nil {
+		return fmt.Errorf("invalid --sort-by %q: %w", field, err)
+	}
+	keys := make([]interface{}, len(items))
+	for i, item := range items {
+		results, err := jpath.FindResults(object(item))
+		if err != nil || len(results) == 0 || len(results[0]) == 0 {
+			continue
+		}
+		keys[i] = results[0][0].Interface()
+	}
+	// the items are sorted through their indexes so that the keys are computed once
+	indexes := make([]int, len(items))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		a, b := keys[indexes[i]], keys[indexes[j]]
+		if a == nil || b == nil {
+			return a != nil
+		}
+		if reverse {
+			return compareValues(b, a) < 0
+		}
+		return compareValues(a, b) < 0
+	})
+	sorted := make([]T, len(items))
+	for i, index := range indexes {
+		sorted[i] = items[index]
+	}
+	copy(items, sorted)
+	return nil
+}
+
+// compareValues compares two values of a field, by the first of their types they share.
+func compareValues(a, b interface{}) int {
+	if x, ok := a.(int64); ok {
+		if y, ok := b.(int64); ok {
+			return cmp.Compare(x, y)
+		}
+	}
+	if x, ok := toFloat(a); ok {
+		if y, ok := toFloat(b); ok {
+			return cmp.Compare(x, y)
+		}
+	}
+	x, aIsString := a.(string)
+	y, bIsString := b.(string)
+	if aIsString && bIsString {
+		if tx, err := time.Parse(time.RFC3339Nano, x); err == nil {
+			if ty, err := time.Parse(time.RFC3339Nano, y); err == nil {
+				return tx.Compare(ty)
+			}
+		}
+	}
+	if qx, ok := toQuantity(a); ok {
+		if qy, ok := toQuantity(b); ok {
+			return qx.Cmp(qy)
+		}
+	}
+	if aIsString && bIsString {
+		return strings.Compare(x, y)
+	}
+	if x, ok := a.(bool); ok {
+		if y, ok := b.(bool); ok {
+			switch {
+			case x == y:
+				return 0
+			case y:
+				return -1
+			}
+			return 1
+		}
+	}
+	// values of different types, lists and maps are compared as JSON
+	return strings.Compare(toJSON(a), toJSON(b))
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+// toQuantity returns a number or a string as a quantity, as the capacity of a node that can be
+// stored as 4 or "4" or "3500m".
+func toQuantity(v interface{}) (resource.Quantity, bool) {
+	s, ok := v.(string)
+	if !ok {
+		if _, isNumber := toFloat(v); !isNumber {
+			return resource.Quantity{}, false
+		}
+		s = fmt.Sprint(v)
+	}
+	q, err := resource.ParseQuantity(s)
+	return q, err == nil
+}
+
+func toJSON(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
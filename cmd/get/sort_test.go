@@ -0,0 +1,56 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package get
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	items := []map[string]interface{}{
+		{"name": "a", "restarts": int64(10), "memory": "1Gi", "time": "2026-01-02T00:00:00Z", "ratio": 0.5},
+		{"name": "b", "restarts": int64(9), "memory": "512Mi", "time": "2026-01-01T23:00:00-02:00", "ratio": int64(1)},
+		{"name": "c", "memory": "2G", "time": "2026-01-01T00:00:00Z"},
+		{"name": "D", "restarts": int64(100), "memory": "1500m"},
+	}
+	tests := []struct {
+		field   string
+		reverse bool
+		want    []string
+		wantErr bool
+	}{
+		{field: "{.metadata.missing}", want: []string{"a", "b", "c", "D"}},
+		{field: "{.name}", want: []string{"D", "a", "b", "c"}},
+		{field: ".restarts", want: []string{"b", "a", "D", "c"}},
+		{field: "restarts", reverse: true, want: []string{"D", "a", "b", "c"}},
+		{field: "{.memory}", want: []string{"D", "b", "a", "c"}},
+		{field: "{.time}", want: []string{"c", "a", "b", "D"}},
+		{field: "{.ratio}", reverse: true, want: []string{"b", "a", "c", "D"}},
+		{field: "{.name", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.field, func(t *testing.T) {
+			sorted := append([]map[string]interface{}{}, items...)
+			err := Sort(sorted, tc.field, tc.reverse, func(item map[string]interface{}) map[string]interface{} {
+				return item
+			})
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error for %q", tc.field)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var names []string
+			for _, item := range sorted {
+				names = append(names, item["name"].(string))
+			}
+			if !reflect.DeepEqual(names, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, names)
+			}
+		})
+	}
+}
@@ -24,8 +24,8 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
-	"text/tabwriter"
 
+	"github.com/gmeghnag/omc/pkg/tableprinter"
 	"github.com/gmeghnag/omc/vars"
 	"github.com/spf13/cobra"
 )
@@ -33,6 +33,7 @@ import (
 const haproxy_config_glob = "/ingress_controllers/*/*/haproxy.config"
 
 var includeOpenShiftNamespaces bool
+var output string
 
 var Backends = &cobra.Command{
 	Use:   "backends",
@@ -50,15 +51,17 @@ var Backends = &cobra.Command{
 		if cmd.Flags().Changed("namespace") {
 			wantedNamespace = vars.Namespace
 		}
-		writer := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', tabwriter.AlignRight)
-		fmt.Fprintln(writer, "NAMESPACE\tNAME\tINGRESSCONTROLLER\tSERVICES\tPORT\tTERMINATION")
+		table := tableprinter.New(tableprinter.Options{Output: output}, "NAMESPACE", "NAME", "INGRESSCONTROLLER", "SERVICES", "PORT", "TERMINATION")
 		for _, configfile := range haproxyConfigFiles(vars.MustGatherRootPath) {
 			backends := parseHAProxyConfig(configfile, wantedNamespace)
 			for _, b := range backends {
-				fmt.Fprintln(writer, b)
+				table.Append(b.cells()...)
 			}
 		}
-		writer.Flush()
+		if err := table.Print(os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, "error:", err)
+			os.Exit(1)
+		}
 	},
 }
 
@@ -127,7 +130,7 @@ type backend struct {
 	service                                              *service
 }
 
-func (b backend) String() string {
+func (b backend) cells() []string {
 	terminationType := func(s string) string {
 		mapping := map[string]string{
 			"be_edge_http": "edge/Redirect",
@@ -137,7 +140,7 @@ func (b backend) String() string {
 		}
 		return mapping[s]
 	}
-	return fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s\t", b.namespace, b.routeName, b.ingressController, b.service.serviceName, b.service.port, terminationType(b.termination))
+	return []string{b.namespace, b.routeName, b.ingressController, b.service.serviceName, b.service.port.String(), terminationType(b.termination)}
 }
 
 func newBackendFromLine(raw []string) *backend {
@@ -36,4 +36,5 @@ func init() {
 		Backends,
 	)
 	Backends.PersistentFlags().BoolVarP(&includeOpenShiftNamespaces, "include-openshift", "", false, "Include default backends from openshift-* namespaces (excluded by default.)")
+	Backends.PersistentFlags().StringVarP(&output, "output", "o", "", "Output format. One of: csv|tsv")
 }
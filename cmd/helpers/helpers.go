@@ -13,16 +13,21 @@ import (
 	"log"
 	"math/rand"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/gmeghnag/omc/pkg/mustgather"
+	"github.com/gmeghnag/omc/pkg/tableprinter"
 	"github.com/gmeghnag/omc/types"
 	"github.com/gmeghnag/omc/vars"
 
-	"github.com/olekukonko/tablewriter"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/util/jsonpath"
 	"sigs.k8s.io/yaml"
 )
@@ -51,22 +56,13 @@ func RandString(length int) string {
 	return StringWithCharset(length, charset)
 }
 
-func PrintTable(headers []string, data [][]string) {
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader(headers)
-	table.SetAutoWrapText(false)
-	table.SetAutoFormatHeaders(true)
-	table.SetHeaderAlignment(tablewriter.ALIGN_LEFT)
-	table.SetAlignment(tablewriter.ALIGN_LEFT)
-	table.SetCenterSeparator("")
-	table.SetColumnSeparator("")
-	table.SetRowSeparator("")
-	table.SetHeaderLine(false)
-	table.SetBorder(false)
-	table.SetTablePadding("   ")
-	table.SetNoWhiteSpace(true)
-	table.AppendBulk(data)
-	table.Render()
+// upperCased returns the headers of a table as they are printed.
+func upperCased(headers []string) []string {
+	out := make([]string, len(headers))
+	for i, header := range headers {
+		out[i] = strings.ToUpper(header)
+	}
+	return out
 }
 
 func FormatDiffTime(diff time.Duration) string {
@@ -132,17 +128,17 @@ func CreateConfigFile(cfgFilePath string) {
 func GetData(data [][]string, allNamespacesFlag bool, showLabels bool, labels string, outputFlag string, column int32, _list []string) [][]string {
 	var toAppend []string
 	if allNamespacesFlag == true {
-		if outputFlag == "" {
+		if outputFlag == "" || tableprinter.IsDocument(outputFlag) {
 			toAppend = _list[0:column] // -A
 		}
-		if outputFlag == "wide" {
+		if outputFlag == "wide" || tableprinter.IsDelimited(outputFlag) {
 			toAppend = _list // -A -o wide
 		}
 	} else {
-		if outputFlag == "" {
+		if outputFlag == "" || tableprinter.IsDocument(outputFlag) {
 			toAppend = _list[1:column]
 		}
-		if outputFlag == "wide" {
+		if outputFlag == "wide" || tableprinter.IsDelimited(outputFlag) {
 			toAppend = _list[1:] // -o wide
 		}
 	}
@@ -155,9 +151,15 @@ func GetData(data [][]string, allNamespacesFlag bool, showLabels bool, labels st
 }
 
 func ExtractLabels(_labels map[string]string) string {
+	keys := make([]string, 0, len(_labels))
+	for k := range _labels {
+		keys = append(keys, k)
+	}
+	// sorted as printed by kubectl
+	sort.Strings(keys)
 	labels := ""
-	for k, v := range _labels {
-		labels += k + "=" + v + ","
+	for _, k := range keys {
+		labels += k + "=" + _labels[k] + ","
 	}
 	if labels == "" {
 		labels = "<none>"
@@ -200,6 +202,28 @@ func ReadYaml(YamlPath string) []byte {
 }
 
 func GetAge(resourcefilePath string, resourceCreationTimeStamp v1.Time) string {
+	t2, ok := gatherTime(resourcefilePath)
+	if !ok {
+		return "Unknown"
+	}
+	diffTime := t2.Sub(resourceCreationTimeStamp.Time).String()
+	d, _ := time.ParseDuration(diffTime)
+	return FormatDiffTime(d)
+
+}
+
+// MustGatherTime returns the time the must-gather was collected, or the current time if it is
+// unknown.
+func MustGatherTime(resourcefilePath string) time.Time {
+	if t, ok := gatherTime(resourcefilePath); ok {
+		return t
+	}
+	return time.Now()
+}
+
+// gatherTime returns the modification time of the timestamp file of the must-gather, or of its
+// resource directories when it is missing.
+func gatherTime(resourcefilePath string) (time.Time, bool) {
 	var ResourceFile fs.FileInfo
 	ResourceFile, err := os.Stat(resourcefilePath + "/timestamp")
 	if err != nil {
@@ -207,15 +231,11 @@ func GetAge(resourcefilePath string, resourceCreationTimeStamp v1.Time) string {
 		if err != nil {
 			ResourceFile, err = os.Stat(resourcefilePath + "/cluster-scoped-resources")
 			if err != nil {
-				return "Unknown"
+				return time.Time{}, false
 			}
 		}
 	}
-	t2 := ResourceFile.ModTime()
-	diffTime := t2.Sub(resourceCreationTimeStamp.Time).String()
-	d, _ := time.ParseDuration(diffTime)
-	return FormatDiffTime(d)
-
+	return ResourceFile.ModTime(), true
 }
 
 func IsDirectory(path string) (bool, error) {
@@ -239,29 +259,23 @@ func Exists(path string) (bool, error) {
 }
 
 func PrintOutput(resource interface{}, columns int16, outputFlag string, resourceName string, allNamespacesFlag bool, showLabels bool, _headers []string, data [][]string, jsonPathTemplate string) bool {
-	var headers []string
-	if outputFlag == "" {
-		if allNamespacesFlag == true {
-			headers = _headers[0:columns]
+	if outputFlag == "" || outputFlag == "wide" || tableprinter.IsDelimited(outputFlag) || tableprinter.IsDocument(outputFlag) {
+		var headers []string
+		if outputFlag == "" || tableprinter.IsDocument(outputFlag) {
+			headers = _headers[:columns]
 		} else {
-			headers = _headers[1:columns]
-		}
-		if showLabels {
-			headers = append(headers, "labels")
-		}
-		PrintTable(headers, data)
-		return false
-	}
-	if outputFlag == "wide" {
-		if allNamespacesFlag == true {
+			// the delimited outputs print the wide columns
 			headers = _headers
-		} else {
-			headers = _headers[1:]
+		}
+		if !allNamespacesFlag {
+			headers = headers[1:]
 		}
 		if showLabels {
-			headers = append(headers, "labels")
+			headers = append(append([]string{}, headers...), "labels")
 		}
-		PrintTable(headers, data)
+		table := tableprinter.New(tableprinter.Options{Output: outputFlag}, upperCased(headers)...)
+		table.AppendRows(data)
+		table.Print(os.Stdout)
 		return false
 	}
 
@@ -360,62 +374,44 @@ func StringInSlice(a string, list []string) bool {
 // 	return isMatching
 // }
 
-func MatchLabelsFromMap(labels map[string]string, selector string) (bool, error) {
+// MatchLabelsFromMap reports whether the labels satisfy the selector, using the full
+// kubernetes label selector syntax: equality-based ('=', '==', '!=') and set-based
+// ('in', 'notin', 'key', '!key') requirements, e.g. "app in (etcd,kube-apiserver),!pod-template-hash".
+func MatchLabelsFromMap(objectLabels map[string]string, selector string) (bool, error) {
 	if selector == "" {
 		return true, nil
 	}
-	selectorArray := strings.Split(selector, ",")
+	labelSelector, err := ParseLabelSelector(selector)
+	if err != nil {
+		return false, err
+	}
+	return labelSelector.Matches(labels.Set(objectLabels)), nil
+}
 
-	for _, s := range selectorArray {
-		if strings.Contains(s, "!=") {
-			split := strings.Split(s, "!=")
-			if len(split) != 2 {
-				return false, fmt.Errorf("invalid labels input")
-			}
-			key := split[0]
-			val := split[1]
-			value, _ := labels[key]
-			if val == value {
-				return false, nil
-			}
-		} else if strings.Contains(s, "==") {
-			split := strings.Split(s, "==")
-			if len(split) != 2 {
-				return false, fmt.Errorf("invalid labels input")
-			}
-			key := split[0]
-			val := split[1]
-			value, isPresent := labels[key]
-			if val != value || !isPresent {
-				return false, nil
-			}
-		} else if strings.Contains(s, "=") {
-			split := strings.Split(s, "=")
-			if len(split) != 2 {
-				return false, fmt.Errorf("invalid labels input")
-			}
-			key := split[0]
-			val := split[1]
-			value, isPresent := labels[key]
-			if val != value || !isPresent {
-				return false, nil
-			}
-		} else if strings.HasPrefix(s, "!") {
-			if strings.Contains(s, "=") || strings.Contains(s[1:], "!") {
-				return false, fmt.Errorf("invalid labels input")
-			}
-			_, isPresent := labels[s[1:]]
-			if isPresent {
-				return false, nil
-			}
-		} else {
-			_, isPresent := labels[s]
-			if isPresent == false {
-				return false, nil
-			}
-		}
+// ParseLabelSelector parses a label selector; when the selector is invalid the
+// returned error names the first offending term.
+func ParseLabelSelector(selector string) (labels.Selector, error) {
+	return mustgather.ParseLabelSelector(selector)
+}
+
+// MatchFieldsFromMap reports whether the object satisfies the field selector, e.g.
+// "status.phase!=Running,spec.nodeName=master-0", with the same '=', '==' and '!='
+// semantics as kubectl. Unlike the API server, any field path of any kind can be used.
+func MatchFieldsFromMap(object map[string]interface{}, selector string) (bool, error) {
+	if selector == "" {
+		return true, nil
+	}
+	fieldSelector, err := fields.ParseSelector(selector)
+	if err != nil {
+		return false, err
 	}
-	return true, nil
+	return MatchFieldSelector(object, fieldSelector), nil
+}
+
+// MatchFieldSelector reports whether the object satisfies an already parsed field selector.
+// Missing fields are treated as empty values.
+func MatchFieldSelector(object map[string]interface{}, selector fields.Selector) bool {
+	return mustgather.MatchFieldSelector(object, selector)
 }
 
 func TranslateTimestamp(timestamp metav1.Time) string {
@@ -470,3 +466,34 @@ func GetNamespaces(path string) []string {
 	return namespaces
 
 }
+
+// ParseAPIPath splits a Kubernetes API request path such as /api/v1/namespaces/<ns>/pods/<name>/log
+// or /apis/<group>/<version>/<resource> into its components.
+func ParseAPIPath(path string) (gvr schema.GroupVersionResource, namespace string, name string, subresource string, ok bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case len(segments) >= 3 && segments[0] == "api":
+		gvr.Version = segments[1]
+		segments = segments[2:]
+	case len(segments) >= 4 && segments[0] == "apis":
+		gvr.Group, gvr.Version = segments[1], segments[2]
+		segments = segments[3:]
+	default:
+		return gvr, "", "", "", false
+	}
+	if segments[0] == "namespaces" && len(segments) > 2 {
+		namespace = segments[1]
+		segments = segments[2:]
+	}
+	switch len(segments) {
+	case 1:
+		gvr.Resource = segments[0]
+	case 2:
+		gvr.Resource, name = segments[0], segments[1]
+	case 3:
+		gvr.Resource, name, subresource = segments[0], segments[1], segments[2]
+	default:
+		return gvr, "", "", "", false
+	}
+	return gvr, namespace, name, subresource, true
+}
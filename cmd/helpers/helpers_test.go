@@ -0,0 +1,106 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package helpers
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestMatchLabelsFromMap(t *testing.T) {
+	objectLabels := map[string]string{
+		"app":               "etcd",
+		"pod-template-hash": "5d4f8",
+		"tier":              "control-plane",
+	}
+	tests := []struct {
+		name     string
+		selector string
+		want     bool
+		wantErr  string
+	}{
+		{name: "empty selector", selector: "", want: true},
+		{name: "equality", selector: "app=etcd", want: true},
+		{name: "double equality", selector: "app==kube-apiserver", want: false},
+		{name: "inequality", selector: "app!=kube-apiserver", want: true},
+		{name: "inequality on missing key", selector: "missing!=value", want: true},
+		{name: "exists", selector: "tier", want: true},
+		{name: "does not exist", selector: "!pod-template-hash", want: false},
+		{name: "in", selector: "app in (etcd,kube-apiserver)", want: true},
+		{name: "in without match", selector: "app in (kube-apiserver,kube-scheduler)", want: false},
+		{name: "notin", selector: "tier notin (worker)", want: true},
+		{name: "notin on missing key", selector: "missing notin (value)", want: true},
+		{name: "combined terms", selector: "app in (etcd,kube-apiserver),!missing,tier=control-plane", want: true},
+		{name: "combined terms without match", selector: "app in (etcd,kube-apiserver),!pod-template-hash", want: false},
+		{name: "unterminated set", selector: "tier=control-plane,app in (etcd", wantErr: `term "app in (etcd"`},
+		{name: "invalid key", selector: "app=etcd,-bad=value", wantErr: `term "-bad=value"`},
+		{name: "empty term", selector: "app=etcd,", wantErr: "empty term"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := MatchLabelsFromMap(objectLabels, tc.selector)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParseAPIPath(t *testing.T) {
+	tests := []struct {
+		path            string
+		wantGVR         schema.GroupVersionResource
+		wantNamespace   string
+		wantName        string
+		wantSubresource string
+		wantOk          bool
+	}{
+		{path: "/api/v1/pods", wantGVR: schema.GroupVersionResource{Version: "v1", Resource: "pods"}, wantOk: true},
+		{path: "/api/v1/namespaces/etcd/pods/etcd-0", wantGVR: schema.GroupVersionResource{Version: "v1", Resource: "pods"}, wantNamespace: "etcd", wantName: "etcd-0", wantOk: true},
+		{path: "/api/v1/namespaces/etcd/pods/etcd-0/log", wantGVR: schema.GroupVersionResource{Version: "v1", Resource: "pods"}, wantNamespace: "etcd", wantName: "etcd-0", wantSubresource: "log", wantOk: true},
+		{path: "/api/v1/namespaces/etcd", wantGVR: schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}, wantName: "etcd", wantOk: true},
+		{path: "/apis/config.openshift.io/v1/clusterversions/version", wantGVR: schema.GroupVersionResource{Group: "config.openshift.io", Version: "v1", Resource: "clusterversions"}, wantName: "version", wantOk: true},
+		{path: "/apis/apps/v1", wantOk: false},
+		{path: "/version", wantOk: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.path, func(t *testing.T) {
+			gvr, namespace, name, subresource, ok := ParseAPIPath(tc.path)
+			if ok != tc.wantOk {
+				t.Fatalf("expected ok=%v, got %v", tc.wantOk, ok)
+			}
+			if !ok {
+				return
+			}
+			if gvr != tc.wantGVR || namespace != tc.wantNamespace || name != tc.wantName || subresource != tc.wantSubresource {
+				t.Errorf("expected %v %q %q %q, got %v %q %q %q", tc.wantGVR, tc.wantNamespace, tc.wantName, tc.wantSubresource, gvr, namespace, name, subresource)
+			}
+		})
+	}
+}
+
+func TestExtractLabels(t *testing.T) {
+	tests := []struct {
+		labels map[string]string
+		want   string
+	}{
+		{labels: nil, want: "<none>"},
+		{labels: map[string]string{"tier": "fe", "app": "web", "kubernetes.io/arch": "amd64"}, want: "app=web,kubernetes.io/arch=amd64,tier=fe"},
+	}
+	for _, tc := range tests {
+		if got := ExtractLabels(tc.labels); got != tc.want {
+			t.Errorf("expected %q, got %q", tc.want, got)
+		}
+	}
+}
@@ -50,10 +50,12 @@ func NewCRILogFilter(wantedLevels []string, delimiter []byte) logLineFilter {
 		switch l {
 		case "info":
 			levels = append(levels, "I")
-		case "warn":
+		case "warn", "warning":
 			levels = append(levels, "W")
 		case "error":
 			levels = append(levels, "E")
+		case "fatal":
+			levels = append(levels, "F")
 		}
 	}
 	if len(levels) > 0 {
@@ -75,19 +77,32 @@ func (c *CRILogFilter) filterLogLine(log []byte) ([]byte, error) {
 		return []byte{}, fmt.Errorf("unexpected timestamp format %q: %v", timeFormatIn, err)
 	}
 
-	// Parse stream type
-	_log := log[idx+1:]
-	idx = bytes.Index(_log, c.delimiter)
-	if idx < 0 {
-		idx = len(string(_log))
-	}
-	stream := string(_log[:idx])
-	if len(stream) == 0 {
-		return []byte{}, nil
-	}
-	if slices.Contains(c.levels, string(stream[0])) && isNumber(stream[1]) {
+	// Parse the klog severity of the message, after the stream type of rotated logs
+	header, ok := parseKlogHeader(CRIMessage(log))
+	if ok && slices.Contains(c.levels, string(header.severity)) {
 		return log, nil
 	}
 
 	return []byte{}, nil
 }
+
+// CRIMessage returns the message of a line of a container log without its timestamp, and
+// without its stream and tag for the rotated logs written by CRI-O, or the line itself if it has
+// no timestamp:
+//
+//	2023-11-02T06:12:08.604741676Z message
+//	2023-11-02T06:12:08.604741676Z stderr F message
+func CRIMessage(line []byte) []byte {
+	if _, ok := CRITimestamp(line); !ok {
+		return line
+	}
+	message := line[bytes.IndexByte(line, ' ')+1:]
+	for _, stream := range [][]byte{[]byte("stdout "), []byte("stderr ")} {
+		if rest, ok := bytes.CutPrefix(message, stream); ok {
+			// skip the tag, P for a partial line or F for a full one
+			_, after, _ := bytes.Cut(rest, []byte{' '})
+			return after
+		}
+	}
+	return message
+}
@@ -95,6 +95,14 @@ func TestCRILogFilterLogLine(t *testing.T) {
 			expectedOutputText:      "",
 			expectedFilteredOutText: "My Error LogMessage",
 		},
+		{
+			name:                    "Input of a rotated log contains filtered message after its stream type",
+			input:                   []byte("2023-11-02T06:12:07.604163885+00:00 stderr F W1102 06:12:07.604158       1 test_app.go:365] My Rotated Warning"),
+			levels:                  []string{"warning"},
+			expectedErrorText:       "",
+			expectedOutputText:      "My Rotated Warning",
+			expectedFilteredOutText: "",
+		},
 	}
 
 	for _, tc := range tests {
@@ -19,3 +19,33 @@ package logs
 type logLineFilter interface {
 	filterLogLine([]byte) ([]byte, error)
 }
+
+// chainFilter applies filters in turn, until one of them filters the line out.
+type chainFilter []logLineFilter
+
+// chainFilters returns a filter applying the non nil filters, or nil if there is none.
+func chainFilters(filters ...logLineFilter) logLineFilter {
+	var chain chainFilter
+	for _, f := range filters {
+		if f != nil {
+			chain = append(chain, f)
+		}
+	}
+	switch len(chain) {
+	case 0:
+		return nil
+	case 1:
+		return chain[0]
+	}
+	return chain
+}
+
+func (c chainFilter) filterLogLine(log []byte) ([]byte, error) {
+	for _, f := range c {
+		var err error
+		if log, err = f.filterLogLine(log); err != nil || len(log) == 0 {
+			return log, err
+		}
+	}
+	return log, nil
+}
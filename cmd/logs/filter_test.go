@@ -0,0 +1,128 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package logs
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const (
+	klogLine    = "2023-11-02T06:12:08.604741676Z E1106 06:12:08.604741       1 controller.go:542] sync failed: the object has been modified"
+	rotatedLine = "2023-11-02T06:12:07.604163885+00:00 stderr F W1102 06:12:07.604158       7 operator.go:12] degraded"
+	jsonLine    = `2023-11-02T06:12:09.000000000Z {"level":"error","logger":"controller","msg":"reconcile failed","v":2,"err":{"code":409}}`
+	plainLine   = "not a log line"
+)
+
+func filtered(t *testing.T, f logLineFilter, lines ...string) []string {
+	t.Helper()
+	var kept []string
+	for _, line := range lines {
+		out, err := f.filterLogLine([]byte(line))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(out) > 0 {
+			kept = append(kept, line)
+		}
+	}
+	return kept
+}
+
+func TestCRIMessage(t *testing.T) {
+	tests := map[string]string{
+		klogLine:                        "E1106 06:12:08.604741       1 controller.go:542] sync failed: the object has been modified",
+		rotatedLine:                     "W1102 06:12:07.604158       7 operator.go:12] degraded",
+		plainLine:                       plainLine,
+		"2023-11-02T06:12:09Z stdout F": "",
+	}
+	for line, want := range tests {
+		if got := string(CRIMessage([]byte(line))); got != want {
+			t.Errorf("%q: expected %q, got %q", line, want, got)
+		}
+	}
+}
+
+func TestParseKlogHeader(t *testing.T) {
+	header, ok := parseKlogHeader(CRIMessage([]byte(rotatedLine)))
+	if !ok {
+		t.Fatal("expected a klog header")
+	}
+	if header.severity != 'W' || header.thread != "7" || header.file != "operator.go" || header.line != 12 || string(header.message) != "degraded" {
+		t.Errorf("unexpected header %+v", header)
+	}
+	for _, message := range []string{"Info: starting", "I1102 starting the operator", "E1106 06:12:08.604741 1 controller.go] message"} {
+		if _, ok := parseKlogHeader([]byte(message)); ok {
+			t.Errorf("%q: expected no klog header", message)
+		}
+	}
+}
+
+func TestGrepFilter(t *testing.T) {
+	lines := []string{klogLine, rotatedLine, jsonLine}
+	grep, err := NewGrepFilter([]string{"^W1102", "reconcile"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := filtered(t, grep, lines...); len(got) != 2 || got[0] != rotatedLine || got[1] != jsonLine {
+		t.Errorf("--grep: unexpected lines %q", got)
+	}
+	grepV, _ := NewGrepFilter([]string{"object has been modified"}, true)
+	if got := filtered(t, grepV, lines...); len(got) != 2 || got[0] != rotatedLine {
+		t.Errorf("--grep-v: unexpected lines %q", got)
+	}
+	if _, err := NewGrepFilter([]string{"("}, true); err == nil || !strings.Contains(err.Error(), "invalid --grep-v") {
+		t.Errorf("expected an invalid expression error, got %v", err)
+	}
+	if f, _ := NewGrepFilter(nil, false); f != nil {
+		t.Errorf("expected no filter without expression, got %v", f)
+	}
+}
+
+func TestWhereFilter(t *testing.T) {
+	lines := []string{klogLine, rotatedLine, jsonLine, plainLine}
+	tests := []struct {
+		name        string
+		expressions []string
+		want        []string
+	}{
+		{name: "json fields", expressions: []string{"level=error,logger=controller"}, want: []string{jsonLine}},
+		{name: "levels of json and klog lines", expressions: []string{"level=error"}, want: []string{klogLine, jsonLine}},
+		{name: "klog level abbreviated", expressions: []string{"level=warn"}, want: []string{rotatedLine}},
+		{name: "klog file and line", expressions: []string{"file=controller.go", "line>=500"}, want: []string{klogLine}},
+		{name: "klog caller", expressions: []string{"caller=operator.go:12"}, want: []string{rotatedLine}},
+		{name: "verbosity", expressions: []string{"v<=2"}, want: []string{jsonLine}},
+		{name: "nested field", expressions: []string{"err.code=409"}, want: []string{jsonLine}},
+		{name: "missing fields match !=", expressions: []string{"logger!=controller"}, want: []string{klogLine, rotatedLine, plainLine}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f, err := NewWhereFilter(tc.expressions)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := filtered(t, f, lines...); strings.Join(got, "\n") != strings.Join(tc.want, "\n") {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+
+	for _, expression := range []string{"level", "=error", "v<=two"} {
+		if _, err := NewWhereFilter([]string{expression}); err == nil || !strings.Contains(err.Error(), "invalid --where") {
+			t.Errorf("%q: expected an invalid condition error, got %v", expression, err)
+		}
+	}
+}
+
+func TestReadWithFilters(t *testing.T) {
+	grepV, _ := NewGrepFilter([]string{"Warning"}, true)
+	log := &LogReader{testdata + "namespaces/test-namespace/pods/test-pod/test-container/test-container/logs/", &[]string{"current.log"}, chainFilters(NewCRILogFilter([]string{"info", "warning"}, nil), grepV), 1, false}
+	var out bytes.Buffer
+	if err := log.Read(&out); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(out.String(), "My Info LogMessage\n") || strings.Count(out.String(), "\n") != 1 {
+		t.Errorf("expected the info line, got %q", out.String())
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package logs
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// grepFilter keeps the lines whose message matches one of its expressions, or with invert,
+// matches none of them.
+type grepFilter struct {
+	expressions []*regexp.Regexp
+	invert      bool
+}
+
+// NewGrepFilter returns a filter of the lines matching one of the regular expressions, as
+// --grep does, or matching none of them with invert, as --grep-v does. It is nil without
+// expressions.
+func NewGrepFilter(patterns []string, invert bool) (logLineFilter, error) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+	f := &grepFilter{invert: invert}
+	flag := "--grep"
+	if invert {
+		flag = "--grep-v"
+	}
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", flag, pattern, err)
+		}
+		f.expressions = append(f.expressions, re)
+	}
+	return f, nil
+}
+
+func (g *grepFilter) filterLogLine(log []byte) ([]byte, error) {
+	message := CRIMessage(log)
+	for _, re := range g.expressions {
+		if re.Match(message) {
+			if g.invert {
+				return []byte{}, nil
+			}
+			return log, nil
+		}
+	}
+	if g.invert {
+		return log, nil
+	}
+	return []byte{}, nil
+}
@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package logs
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// klogHeader is the header of the lines logged by klog:
+//
+//	Lmmdd hh:mm:ss.uuuuuu threadid file:line] msg
+type klogHeader struct {
+	// severity is I, W, E or F
+	severity byte
+	thread   string
+	file     string
+	line     int
+	message  []byte
+}
+
+// klogLevels are the levels of the klog severities.
+var klogLevels = map[byte]string{'I': "info", 'W': "warning", 'E': "error", 'F': "fatal"}
+
+// parseKlogHeader parses the header of a message logged by klog.
+func parseKlogHeader(message []byte) (klogHeader, bool) {
+	var h klogHeader
+	// Lmmdd
+	if len(message) < 5 || klogLevels[message[0]] == "" || !isDigits(message[1:5]) {
+		return h, false
+	}
+	h.severity = message[0]
+	fields := message[5:]
+	// hh:mm:ss.uuuuuu, the thread id, padded, and file:line]
+	var field []byte
+	for i := 0; i < 3; i++ {
+		fields = bytes.TrimLeft(fields, " ")
+		var found bool
+		field, fields, found = bytes.Cut(fields, []byte{' '})
+		if !found && i < 2 {
+			return h, false
+		}
+		switch i {
+		case 0:
+			if bytes.Count(field, []byte{':'}) != 2 {
+				return h, false
+			}
+		case 1:
+			h.thread = string(field)
+		case 2:
+			source, ok := bytes.CutSuffix(field, []byte{']'})
+			if !ok {
+				return h, false
+			}
+			file, line, ok := bytes.Cut(source, []byte{':'})
+			if !ok {
+				return h, false
+			}
+			n, err := strconv.Atoi(string(line))
+			if err != nil {
+				return h, false
+			}
+			h.file, h.line = string(file), n
+		}
+	}
+	h.message = fields
+	return h, true
+}
+
+func isDigits(b []byte) bool {
+	for _, c := range b {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
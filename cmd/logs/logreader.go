@@ -17,14 +17,13 @@ package logs
 
 import (
 	"bufio"
-	"compress/gzip"
 	"fmt"
 	"io"
-	"io/fs"
-	"log"
+	"iter"
 	"os"
 	"path/filepath"
-	"strings"
+
+	"github.com/gmeghnag/omc/pkg/mustgather"
 )
 
 // logReader reads (current/previous/rotated) logs from a tree in a base directory:
@@ -38,10 +37,10 @@ import (
 //     └── 0.log.zzz
 
 const (
-	currentLogFile          = "current.log"
-	previousLogFile         = "previous.log"
-	previousInsecureLogFile = "previous.insecure.log"
-	rotatedLogDir           = "rotated"
+	currentLogFile          = mustgather.CurrentLogFile
+	previousLogFile         = mustgather.PreviousLogFile
+	previousInsecureLogFile = mustgather.PreviousInsecureLogFile
+	rotatedLogDir           = mustgather.RotatedLogDir
 )
 
 type LogReader struct {
@@ -49,6 +48,8 @@ type LogReader struct {
 	files   *[]string
 	filter  logLineFilter
 	tail    int64
+	// detached is set to read the files without holding their file descriptors
+	detached bool
 }
 
 // Create a LogReader which holds a reader to either a plain (bufio.Reader) or gzipped (gzip.Reader) logfile.
@@ -61,28 +62,43 @@ func NewLogReader(dirname string) *LogReader {
 	return l
 }
 
+// Create a LogReader for a single log file, such as the journal of a node service.
+func NewFileLogReader(filename string) *LogReader {
+	return &LogReader{dirname: filepath.Dir(filename), files: &[]string{filepath.Base(filename)}, tail: -1}
+}
+
+// Create a LogReader for the log files of a container located by the mustgather.Reader.
+func NewContainerLogReader(c mustgather.ContainerLog) *LogReader {
+	files := c.Files
+	return &LogReader{dirname: c.Dir, files: &files, tail: -1}
+}
+
 func (l *LogReader) WithFilter(llf logLineFilter) {
 	l.filter = llf
 }
 
+// WithTimeWindow only reads the lines logged in a time window, as timestamped by parse.
+func (l *LogReader) WithTimeWindow(window TimeWindow, parse TimestampParser) {
+	l.filter = chainFilters(l.filter, NewTimeWindowFilter(window, parse))
+}
+
 func (l *LogReader) WithTail(tail int64) {
 	l.tail = tail
 }
 
+// WithDetachedFiles reads the log files without holding them open between the reads, for the
+// callers reading the logs of many containers at once.
+func (l *LogReader) WithDetachedFiles() {
+	l.detached = true
+}
+
 func (l *LogReader) FromPrevious() {
 	l.files = &[]string{previousLogFile}
 }
 
 func (l *LogReader) FromInsecure() {
 	// iterate selected logs and read from its insecure siblings instead
-	insecure := []string{}
-	for _, f := range *l.files {
-		nf := strings.TrimSuffix(f, ".log")
-		if f != nf {
-			insecure = append(insecure, nf+".insecure.log")
-		}
-	}
-	*l.files = insecure
+	*l.files = mustgather.InsecureLogFiles(*l.files)
 }
 
 func (l *LogReader) FromRotated() {
@@ -93,53 +109,115 @@ func (l *LogReader) FromRotated() {
 // If unfilter, write to provided writer (w).
 // If filtered read from reader line-by-line and apply the filter.
 func (l *LogReader) Read(w io.Writer) error {
+	if l.filter == nil && l.tail == -1 {
+		// without filter and without tail, copy entire content to the provided writer
+		for _, filename := range *l.files {
+			reader, err := l.open(l.dirname + "/" + filename)
+			if err != nil {
+				if os.IsNotExist(err) {
+					// Must-gathers may omit selected current, previous, or rotated logs.
+					continue
+				}
+				return fmt.Errorf("failed to open log file %s: %w", filename, err)
+			}
+			_, err = io.Copy(w, reader)
+			reader.Close()
+			if err != nil {
+				return fmt.Errorf("copy log file %s: %w", filename, err)
+			}
+		}
+		return nil
+	}
+	// with filter or tail, read line by line
+	return l.read(w, l.Lines())
+}
+
+// ReadStitched prints the lines of the log files merged by time into one stream, dropping the
+// lines repeated in several files, for files which overlap as the rotated, previous and current
+// logs of a container do. The filter and tail apply to the merged lines.
+func (l *LogReader) ReadStitched(w io.Writer) error {
+	return l.read(w, l.StitchedLines())
+}
+
+func (l *LogReader) read(w io.Writer, lines iter.Seq2[[]byte, error]) error {
 	var logs []string
-	for _, filename := range *l.files {
-		reader, err := open(l.dirname + "/" + filename)
+	for log, err := range lines {
 		if err != nil {
-			if os.IsNotExist(err) {
-				// Must-gathers may omit selected current, previous, or rotated logs.
-				continue
-			}
-			return fmt.Errorf("failed to open log file %s: %w", filename, err)
+			return err
 		}
-		defer reader.Close()
-		if l.filter == nil && l.tail == -1 {
-			// without filter and without tail, copy entire content to the provided writer
-			if _, err := io.Copy(w, reader); err != nil {
-				return fmt.Errorf("copy log file %s: %w", filename, err)
+		if l.tail != -1 {
+			logs = append(logs, string(log))
+			if int64(len(logs)) > l.tail {
+				logs = logs[1:]
 			}
-		} else {
-			// with filter or tail, read line by line
-			scanner := bufio.NewScanner(reader)
-			for scanner.Scan() {
-				log := l.applyFilter(scanner.Bytes())
-				if len(log) > 0 {
-					if l.tail != -1 {
-						logs = append(logs, string(log))
-						if int64(len(logs)) > l.tail {
-							logs = logs[1:]
-						}
-					} else {
-						if _, err := fmt.Fprintln(w, string(log)); err != nil {
-							return fmt.Errorf("write log line from %s: %w", filename, err)
-						}
-					}
+		} else if _, err := fmt.Fprintln(w, string(log)); err != nil {
+			return fmt.Errorf("write log line: %w", err)
+		}
+	}
+	for _, logLine := range logs {
+		if _, err := fmt.Fprintln(w, logLine); err != nil {
+			return fmt.Errorf("write tailed log line: %w", err)
+		}
+	}
+	return nil
+}
+
+// Lines iterates over the (filtered) lines of the log files, one file open at a time, without
+// their line break. A line is only valid until the next iteration.
+func (l *LogReader) Lines() iter.Seq2[[]byte, error] {
+	return func(yield func([]byte, error) bool) {
+		for _, filename := range *l.files {
+			for log, err := range fileLines(l.open, l.dirname, filename) {
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+				if log = l.applyFilter(log); len(log) > 0 && !yield(log, nil) {
+					return
 				}
 			}
-			if err := scanner.Err(); err != nil {
-				return fmt.Errorf("scan log file %s: %w", filename, err)
+		}
+	}
+}
+
+// StitchedLines iterates over the (filtered) lines of the log files merged by time, as
+// ReadStitched reads them. A line is only valid until the next iteration.
+func (l *LogReader) StitchedLines() iter.Seq2[[]byte, error] {
+	return func(yield func([]byte, error) bool) {
+		for log, err := range stitch(l.open, l.dirname, *l.files) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if log = l.applyFilter(log); len(log) > 0 && !yield(log, nil) {
+				return
 			}
 		}
 	}
-	if l.tail != -1 {
-		for _, logLine := range logs {
-			if _, err := fmt.Fprintln(w, logLine); err != nil {
-				return fmt.Errorf("write tailed log line: %w", err)
+}
+
+// fileLines iterates over the lines of a log file opened by openFile, none if it does not exist.
+func fileLines(openFile func(string) (io.ReadCloser, error), dirname string, filename string) iter.Seq2[[]byte, error] {
+	return func(yield func([]byte, error) bool) {
+		reader, err := openFile(dirname + "/" + filename)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				// Must-gathers may omit selected current, previous, or rotated logs.
+				yield(nil, fmt.Errorf("failed to open log file %s: %w", filename, err))
+			}
+			return
+		}
+		defer reader.Close()
+		scanner := bufio.NewScanner(reader)
+		for scanner.Scan() {
+			if !yield(scanner.Bytes(), nil) {
+				return
 			}
 		}
+		if err := scanner.Err(); err != nil {
+			yield(nil, fmt.Errorf("scan log file %s: %w", filename, err))
+		}
 	}
-	return nil
 }
 
 func (l *LogReader) applyFilter(raw []byte) []byte {
@@ -155,40 +233,19 @@ func (l *LogReader) applyFilter(raw []byte) []byte {
 
 // open the logfile and return either a *os.File or *gzip.Reader
 func open(filename string) (io.ReadCloser, error) {
-	file, err := os.Open(filename)
-	if err != nil {
-		return nil, err
-	}
-	var reader io.ReadCloser
-	reader, err = gzip.NewReader(file)
-	if err != nil {
-		// after trying to read in a gzip.Reader, reset the offset to start
-		file.Seek(0, io.SeekStart)
-		reader = file
+	return mustgather.OpenLogFile(filename)
+}
+
+// open opens a log file of the reader, detached if set.
+func (l *LogReader) open(filename string) (io.ReadCloser, error) {
+	if l.detached {
+		return mustgather.OpenDetachedLogFile(filename)
 	}
-	//	defer file.Close()
-	return reader, nil
+	return open(filename)
 }
 
 // read rotated dir and return relative filenames for plain and gzipped logfiles
 func rotatedFiles(rotatedDir string) *[]string {
-	var files []string
-	err := filepath.WalkDir(rotatedDir+"/"+rotatedLogDir,
-		func(path string, d fs.DirEntry, err error) error {
-			if err != nil {
-				return err
-			}
-			if d.Type().IsRegular() {
-				files = append(files, filepath.Join(rotatedLogDir, d.Name()))
-			}
-			return nil
-		})
-	if err != nil {
-		// if rotated dir does not exist, return empty slice
-		if os.IsNotExist(err) {
-			return &files
-		}
-		log.Println(err)
-	}
+	files := mustgather.RotatedLogFiles(rotatedDir)
 	return &files
 }
@@ -69,7 +69,7 @@ func TestFromInsecure(t *testing.T) {
 		},
 		{
 			name:      "Handle insecure logs but only touch files suffixed .log",
-			logReader: &LogReader{"", &[]string{"current.log", "current.fakelog"}, nil, -1},
+			logReader: &LogReader{"", &[]string{"current.log", "current.fakelog"}, nil, -1, false},
 			expected:  &[]string{"current.insecure.log"},
 		},
 	}
@@ -136,13 +136,13 @@ func TestRead(t *testing.T) {
 	}{
 		{
 			name:                    "Read file unfiltered",
-			logReader:               &LogReader{testdata + "namespaces/test-namespace/pods/test-pod/test-container/test-container/logs/", &[]string{"current.log"}, nil, -1},
+			logReader:               &LogReader{testdata + "namespaces/test-namespace/pods/test-pod/test-container/test-container/logs/", &[]string{"current.log"}, nil, -1, false},
 			expectedText:            "My Info LogMessage",
 			expectedFilteredOutText: "",
 		},
 		{
 			name:                    "Read file and apply filter to every line.",
-			logReader:               &LogReader{testdata + "namespaces/test-namespace/pods/test-pod/test-container/test-container/logs/", &[]string{"current.log"}, &SimpleToUpperLogFilter{}, -1},
+			logReader:               &LogReader{testdata + "namespaces/test-namespace/pods/test-pod/test-container/test-container/logs/", &[]string{"current.log"}, &SimpleToUpperLogFilter{}, -1, false},
 			expectedText:            "LOGMESSAGE",
 			expectedFilteredOutText: "LogMessage",
 		},
@@ -176,19 +176,19 @@ func TestReadWithTail(t *testing.T) {
 	}{
 		{
 			name:           "Read file tail 1",
-			logReader:      &LogReader{testdata + "namespaces/test-namespace/pods/test-pod/test-container/test-container/logs/", &[]string{"current.log"}, nil, 1},
+			logReader:      &LogReader{testdata + "namespaces/test-namespace/pods/test-pod/test-container/test-container/logs/", &[]string{"current.log"}, nil, 1, false},
 			expectedText:   "My Error LogMessage",
 			unexpectedText: "My Info LogMessage",
 		},
 		{
 			name:           "Read file tail 2",
-			logReader:      &LogReader{testdata + "namespaces/test-namespace/pods/test-pod/test-container/test-container/logs/", &[]string{"current.log"}, nil, 2},
+			logReader:      &LogReader{testdata + "namespaces/test-namespace/pods/test-pod/test-container/test-container/logs/", &[]string{"current.log"}, nil, 2, false},
 			expectedText:   "My Warning LogMessage",
 			unexpectedText: "My Info LogMessage",
 		},
 		{
 			name:           "Read file tail 0",
-			logReader:      &LogReader{testdata + "namespaces/test-namespace/pods/test-pod/test-container/test-container/logs/", &[]string{"current.log"}, nil, 0},
+			logReader:      &LogReader{testdata + "namespaces/test-namespace/pods/test-pod/test-container/test-container/logs/", &[]string{"current.log"}, nil, 0, false},
 			expectedText:   "",
 			unexpectedText: "LogMessage",
 		},
@@ -19,8 +19,10 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/pkg/mustgather"
 	"github.com/gmeghnag/omc/vars"
 
 	"github.com/spf13/cobra"
@@ -28,10 +30,46 @@ import (
 
 var LogLevel string
 
+var allPods, prefix, allLogs bool
+
+var since time.Duration
+
+var sinceTime, untilTime string
+
+var grep, grepV, where []string
+
 // logsCmd represents the logs command
 var Logs = &cobra.Command{
-	Use:          "logs",
-	Short:        "Print the logs for a container in a pod",
+	Use:   "logs",
+	Short: "Print the logs for a container in a pod",
+	Long: `Print the logs for a container in a pod, or in the pods of a workload or matching a selector.
+
+A deployment, replicaset, daemonset, statefulset or job is resolved to its pods through their
+ownerReferences and its label selector. The logs of a single pod are printed, the first running
+one, unless --all-pods is set.`,
+	Example: `  # Print the logs of the default container of a pod of a deployment
+  omc logs deployment/etcd-operator
+
+  # Print the logs of all the pods of a daemonset
+  omc logs ds/ovnkube-node --all-pods
+
+  # Print the logs of the pods labeled app=etcd in all namespaces, each line prefixed by its source
+  omc logs -l app=etcd -A --prefix
+
+  # Print the logs of a pod logged during an incident
+  omc logs etcd-master-0 -c etcd --since-time 2026-10-01T10:00:00Z --until-time 2026-10-01T10:15:00Z
+
+  # Print the errors of a controller logging JSON lines, except the conflicts
+  omc logs etcd-operator-7b9d4-x2x5q --where 'level=error,logger=controller' --grep-v 'the object has been modified'
+
+  # Print the lines of the rotated logs of a pod logged by a file, from their klog header
+  omc logs etcd-operator-7b9d4-x2x5q --rotated --where file=operator.go --tail 20
+
+  # Print the last 100 lines of all the logs of a container, across its restarts and rotations
+  omc logs etcd-master-0 -c etcd --all-logs --tail 100
+
+  # Print the logs of a pod of the last 10 minutes before the must-gather was collected
+  omc logs etcd-master-0 -c etcd --since 10m`,
 	SilenceUsage: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if vars.MustGatherRootPath == "" {
@@ -59,55 +97,75 @@ var Logs = &cobra.Command{
 		if namespaceFlag != "" {
 			vars.Namespace = namespaceFlag
 		}
-		podName := ""
 		containerName, _ := cmd.Flags().GetString("container")
 		previousFlag, _ := cmd.Flags().GetBool("previous")
 		rotatedFlag, _ := cmd.Flags().GetBool("rotated")
 		insecureFlag, _ := cmd.Flags().GetBool("insecure")
 		allContainersFlag, _ := cmd.Flags().GetBool("all-containers")
+		if allLogs && (previousFlag || rotatedFlag) {
+			return fmt.Errorf("only one of --all-logs, --previous or --rotated is allowed")
+		}
+		printOpts := printOptions{tail: vars.Tail, prefix: prefix, allLogs: allLogs}
 		logLevels := []string{}
 		if LogLevel != "" {
 			logLevels = strings.Split(LogLevel, ",")
 		}
+		grepFilter, err := NewGrepFilter(grep, false)
+		if err != nil {
+			return err
+		}
+		grepVFilter, err := NewGrepFilter(grepV, true)
+		if err != nil {
+			return err
+		}
+		whereFilter, err := NewWhereFilter(where)
+		if err != nil {
+			return err
+		}
+		printOpts.filter = chainFilters(NewCRILogFilter(logLevels, nil), grepFilter, grepVFilter, whereFilter)
+		window, err := NewTimeWindow(since, sinceTime, untilTime, helpers.MustGatherTime(vars.MustGatherRootPath))
+		if err != nil {
+			return err
+		}
+		printOpts.window = window
 
+		if vars.LabelSelectorStringVar != "" {
+			if len(args) > 0 {
+				return fmt.Errorf("only one of a selector (-l) or a POD or TYPE/NAME argument is allowed")
+			}
+			namespace := vars.Namespace
+			if vars.AllNamespaceBoolVar {
+				namespace = ""
+			}
+			opts := mustgather.PodLogOptions{Container: containerName, AllContainers: allContainersFlag, Previous: previousFlag, Rotated: rotatedFlag, AllLogs: allLogs, Insecure: insecureFlag}
+			return logsSelector(mustgather.NewReader(vars.MustGatherRootPath), namespace, vars.LabelSelectorStringVar, opts, printOpts)
+		}
 		if len(args) == 0 || len(args) > 2 {
 			return fmt.Errorf("expected 'logs [-p] (POD | TYPE/NAME) [-c CONTAINER]'; POD or TYPE/NAME is a required argument for the logs command")
 		}
-		if len(args) == 1 {
-			if s := strings.Split(args[0], "/"); len(s) == 2 && (s[0] == "po" || s[0] == "pod" || s[0] == "pods") {
-				podName = s[1]
-				if podName == "" {
-					return fmt.Errorf("arguments in resource/name form must have a single resource and name")
-				}
-				return logsPods(vars.MustGatherRootPath, vars.Namespace, podName, containerName, previousFlag, rotatedFlag, allContainersFlag, logLevels, insecureFlag, vars.Tail)
-			} else {
-				podName = s[0]
-				return logsPods(vars.MustGatherRootPath, vars.Namespace, podName, containerName, previousFlag, rotatedFlag, allContainersFlag, logLevels, insecureFlag, vars.Tail)
+		if len(args) == 2 {
+			if containerName != "" {
+				return fmt.Errorf("only one of -c or an inline [CONTAINER] arg is allowed")
 			}
+			containerName = args[1]
 		}
-		if len(args) == 2 {
-			if s := strings.Split(args[0], "/"); len(s) == 2 && (s[0] == "po" || s[0] == "pod" || s[0] == "pods") {
-				if containerName != "" {
-					return fmt.Errorf("only one of -c or an inline [CONTAINER] arg is allowed")
-				} else {
-					podName = s[1]
-					if podName == "" {
-						return fmt.Errorf("arguments in resource/name form must have a single resource and name")
-					}
-					containerName = args[1]
-					return logsPods(vars.MustGatherRootPath, vars.Namespace, podName, containerName, previousFlag, rotatedFlag, allContainersFlag, logLevels, insecureFlag, vars.Tail)
-				}
-			} else {
-				if containerName != "" {
-					return fmt.Errorf("only one of -c or an inline [CONTAINER] arg is allowed")
-				} else {
-					podName = args[0]
-					containerName = args[1]
-					return logsPods(vars.MustGatherRootPath, vars.Namespace, podName, containerName, previousFlag, rotatedFlag, allContainersFlag, logLevels, insecureFlag, vars.Tail)
+		podName := args[0]
+		if s := strings.Split(args[0], "/"); len(s) > 1 {
+			if len(s) != 2 || s[1] == "" {
+				return fmt.Errorf("arguments in resource/name form must have a single resource and name")
+			}
+			resource := strings.ToLower(s[0])
+			if !isPodResource(resource) {
+				gvr, ok := workloadResources[resource]
+				if !ok {
+					return fmt.Errorf("cannot get the logs of %s: the resource type is not supported", s[0])
 				}
+				opts := mustgather.PodLogOptions{Container: containerName, AllContainers: allContainersFlag, Previous: previousFlag, Rotated: rotatedFlag, AllLogs: allLogs, Insecure: insecureFlag}
+				return logsWorkload(mustgather.NewReader(vars.MustGatherRootPath), gvr, vars.Namespace, s[1], allPods, opts, printOpts)
 			}
+			podName = s[1]
 		}
-		return nil
+		return logsPods(vars.MustGatherRootPath, vars.Namespace, podName, containerName, previousFlag, rotatedFlag, allContainersFlag, insecureFlag, printOpts)
 	},
 }
 
@@ -116,7 +174,18 @@ func init() {
 	Logs.PersistentFlags().BoolVar(&vars.InsecureLogs, "insecure", false, "")
 	Logs.PersistentFlags().BoolVarP(&vars.Previous, "previous", "p", false, "Print the logs for the previous instance of the container in a pod if it exists.")
 	Logs.PersistentFlags().BoolVarP(&vars.Rotated, "rotated", "r", false, "Print the logs for the rotated instance of the container in a pod if it exists.")
+	Logs.PersistentFlags().BoolVar(&allLogs, "all-logs", false, "Print the rotated, previous and current logs of the container as one chronological stream, without the lines repeated in several of them.")
 	Logs.PersistentFlags().BoolVarP(&vars.AllContainers, "all-containers", "", false, "Get all containers' logs in the pod(s).")
+	Logs.PersistentFlags().DurationVar(&since, "since", 0, "Only return logs newer than a relative duration like 5s, 2m, or 3h, before the must-gather was collected. Only one of since-time / since may be used.")
+	Logs.PersistentFlags().StringVar(&sinceTime, "since-time", "", "Only return logs after a specific date (RFC3339). Only one of since-time / since may be used.")
+	Logs.PersistentFlags().StringVar(&untilTime, "until-time", "", "Only return logs before a specific date (RFC3339).")
 	Logs.PersistentFlags().Int64Var(&vars.Tail, "tail", -1, "Lines of recent log file to display. Defaults to -1 with no selector, showing all log lines.")
-	Logs.Flags().StringVarP(&LogLevel, "log-level", "l", "", "Filter logs by level (info|error|worning), you can filter for more concatenating them comma separated.")
+	Logs.Flags().StringVarP(&vars.LabelSelectorStringVar, "selector", "l", "", "Selector (label query) to filter on, supports '=', '==', '!=', 'in', 'notin', 'key' and '!key'.(e.g. -l key1=value1,key2 in (value2,value3),!key3)")
+	Logs.Flags().BoolVarP(&vars.AllNamespaceBoolVar, "all-namespaces", "A", false, "If present, print the logs of the pods matching the selector across all namespaces.")
+	Logs.Flags().BoolVar(&allPods, "all-pods", false, "Print the logs of all the pods of a workload, instead of one of them.")
+	Logs.Flags().BoolVar(&prefix, "prefix", false, "Prefix each log line with the log source (pod name and container name).")
+	Logs.Flags().StringVar(&LogLevel, "log-level", "", "Filter logs by klog level (info|warning|error|fatal), you can filter for more concatenating them comma separated.")
+	Logs.Flags().StringArrayVar(&grep, "grep", nil, "Only print the lines whose message matches this regular expression. Can be repeated to print the lines matching any of them.")
+	Logs.Flags().StringArrayVar(&grepV, "grep-v", nil, "Don't print the lines whose message matches this regular expression. Can be repeated.")
+	Logs.Flags().StringArrayVar(&where, "where", nil, "Only print the lines whose fields, of JSON structured logs or of klog headers (level, file, line, caller, thread), match all these comma separated conditions (e.g. 'level=error,logger=controller' or 'line>=400'). The verbosity 'v' is only a field of JSON logs, klog headers do not hold it. Can be repeated.")
 }
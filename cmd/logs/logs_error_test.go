@@ -81,7 +81,7 @@ func TestLogsPodsReturnsErrors(t *testing.T) {
 	t.Run("missing pod", func(t *testing.T) {
 		root := writePodsListFixture(t, podListYAML("other-pod", "test-container"))
 
-		err := logsPods(root, "test-namespace", "test-pod", "", false, false, false, nil, false, -1)
+		err := logsPods(root, "test-namespace", "test-pod", "", false, false, false, false, printOptions{tail: -1})
 		if err == nil {
 			t.Fatalf("expected missing pod error, got nil")
 		}
@@ -93,7 +93,7 @@ func TestLogsPodsReturnsErrors(t *testing.T) {
 	t.Run("invalid container", func(t *testing.T) {
 		root := writePodsListFixture(t, podListYAML("test-pod", "test-container"))
 
-		err := logsPods(root, "test-namespace", "test-pod", "missing-container", false, false, false, nil, false, -1)
+		err := logsPods(root, "test-namespace", "test-pod", "missing-container", false, false, false, false, printOptions{tail: -1})
 		if err == nil {
 			t.Fatalf("expected invalid container error, got nil")
 		}
@@ -105,7 +105,7 @@ func TestLogsPodsReturnsErrors(t *testing.T) {
 	t.Run("corrupt pods list", func(t *testing.T) {
 		root := writePodsListFixture(t, "{ unterminated")
 
-		err := logsPods(root, "test-namespace", "test-pod", "", false, false, false, nil, false, -1)
+		err := logsPods(root, "test-namespace", "test-pod", "", false, false, false, false, printOptions{tail: -1})
 		if err == nil {
 			t.Fatalf("expected corrupt pods list error, got nil")
 		}
@@ -124,7 +124,7 @@ func TestLogsPodsReturnsErrors(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		err := logsPods(root, "test-namespace", "test-pod", "", false, false, false, nil, false, -1)
+		err := logsPods(root, "test-namespace", "test-pod", "", false, false, false, false, printOptions{tail: -1})
 		if err == nil {
 			t.Fatalf("expected corrupt fallback pod error, got nil")
 		}
@@ -172,6 +172,68 @@ func TestLogsCommandReturnsErrors(t *testing.T) {
 			t.Fatalf("expected container conflict error, got %v", err)
 		}
 	})
+
+	t.Run("selector conflicts with a pod argument", func(t *testing.T) {
+		root := writeLogsRoot(t)
+		restoreLogsCommandState(t)
+		vars.MustGatherRootPath = root
+
+		var stdout, stderr bytes.Buffer
+		Logs.SetOut(&stdout)
+		Logs.SetErr(&stderr)
+		Logs.SetArgs([]string{"-l", "app=etcd", "test-pod"})
+		err := Logs.Execute()
+		if err == nil || !strings.Contains(err.Error(), "only one of a selector (-l) or a POD or TYPE/NAME argument is allowed") {
+			t.Fatalf("expected selector conflict error, got %v", err)
+		}
+	})
+
+	t.Run("all logs conflicts with previous", func(t *testing.T) {
+		root := writeLogsRoot(t)
+		restoreLogsCommandState(t)
+		vars.MustGatherRootPath = root
+
+		var stdout, stderr bytes.Buffer
+		Logs.SetOut(&stdout)
+		Logs.SetErr(&stderr)
+		Logs.SetArgs([]string{"--all-logs", "-p", "test-pod"})
+		err := Logs.Execute()
+		if err == nil || !strings.Contains(err.Error(), "only one of --all-logs, --previous or --rotated is allowed") {
+			t.Fatalf("expected all logs conflict error, got %v", err)
+		}
+	})
+
+	t.Run("unsupported resource type", func(t *testing.T) {
+		root := writeLogsRoot(t)
+		restoreLogsCommandState(t)
+		vars.MustGatherRootPath = root
+
+		var stdout, stderr bytes.Buffer
+		Logs.SetOut(&stdout)
+		Logs.SetErr(&stderr)
+		Logs.SetArgs([]string{"svc/etcd"})
+		err := Logs.Execute()
+		if err == nil || !strings.Contains(err.Error(), "the resource type is not supported") {
+			t.Fatalf("expected unsupported resource error, got %v", err)
+		}
+	})
+
+	t.Run("missing workload", func(t *testing.T) {
+		root := writeLogsRoot(t)
+		restoreLogsCommandState(t)
+		vars.MustGatherRootPath = root
+
+		vars.Namespace = "test-namespace"
+
+		var stdout, stderr bytes.Buffer
+		Logs.SetOut(&stdout)
+		Logs.SetErr(&stderr)
+		Logs.SetArgs([]string{"deployment/etcd-operator"})
+		err := Logs.Execute()
+		if err == nil || !strings.Contains(err.Error(), "deployments etcd-operator not found") {
+			t.Fatalf("expected not found error, got %v", err)
+		}
+	})
 }
 
 func writeLogsRoot(t *testing.T) string {
@@ -221,6 +283,11 @@ func restoreLogsCommandState(t *testing.T) {
 	savedInsecureLogs := vars.InsecureLogs
 	savedTail := vars.Tail
 	savedLogLevel := LogLevel
+	savedSelector := vars.LabelSelectorStringVar
+	savedAllNamespaces := vars.AllNamespaceBoolVar
+	savedAllPods := allPods
+	savedPrefix := prefix
+	savedAllLogs := allLogs
 
 	t.Cleanup(func() {
 		Logs.SetArgs(nil)
@@ -240,6 +307,16 @@ func restoreLogsCommandState(t *testing.T) {
 		vars.AllContainers = savedAllContainers
 		vars.InsecureLogs = savedInsecureLogs
 		vars.Tail = savedTail
+		_ = Logs.Flags().Set("selector", savedSelector)
+		_ = Logs.Flags().Set("all-namespaces", strconv.FormatBool(savedAllNamespaces))
+		_ = Logs.Flags().Set("all-pods", strconv.FormatBool(savedAllPods))
+		_ = Logs.Flags().Set("prefix", strconv.FormatBool(savedPrefix))
+		_ = Logs.PersistentFlags().Set("all-logs", strconv.FormatBool(savedAllLogs))
 		LogLevel = savedLogLevel
+		vars.LabelSelectorStringVar = savedSelector
+		vars.AllNamespaceBoolVar = savedAllNamespaces
+		allPods = savedAllPods
+		prefix = savedPrefix
+		allLogs = savedAllLogs
 	})
 }
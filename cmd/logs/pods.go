@@ -16,113 +16,111 @@ limitations under the License.
 package logs
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"os"
 
-	v1 "k8s.io/api/core/v1"
-	"sigs.k8s.io/yaml"
+	"github.com/gmeghnag/omc/pkg/mustgather"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
-func logsPods(currentContextPath string, defaultConfigNamespace string, podName string, containerName string, previousFlag bool, rotatedFlag bool, allContainersFlag bool, logLevels []string, insecureFlag bool, tail int64) error {
-	var logFilter logLineFilter = NewCRILogFilter(logLevels, nil)
-	var _Items v1.PodList
-	CurrentNamespacePath := currentContextPath + "/namespaces/" + defaultConfigNamespace
-	podsPath := CurrentNamespacePath + "/core/pods.yaml"
-	_file, err := os.ReadFile(podsPath)
+// printOptions select the log lines to print and how.
+type printOptions struct {
+	filter logLineFilter
+	window TimeWindow
+	tail   int64
+	prefix bool
+	// allLogs stitches the rotated, previous and current logs of each container
+	allLogs bool
+}
+
+func logsPods(currentContextPath string, defaultConfigNamespace string, podName string, containerName string, previousFlag bool, rotatedFlag bool, allContainersFlag bool, insecureFlag bool, printOpts printOptions) error {
+	containerLogs, err := mustgather.NewReader(currentContextPath).PodLogs(defaultConfigNamespace, podName, mustgather.PodLogOptions{
+		Container:     containerName,
+		AllContainers: allContainersFlag,
+		Previous:      previousFlag,
+		Rotated:       rotatedFlag,
+		Insecure:      insecureFlag,
+		AllLogs:       printOpts.allLogs,
+	})
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("pods %s not found", podName)
+	}
 	if err != nil {
-		// Sometimes the core/pods.yaml might be empty due to unknown reasons when MG is collected
-		// In such cases, we need to look for the pod in the pods directory
-		podPath := CurrentNamespacePath + "/pods/" + podName + "/" + podName + ".yaml"
-		_file, err = os.ReadFile(podPath)
+		return err
+	}
+	return printLogs(os.Stdout, containerLogs, printOpts)
+}
+
+// logsSelectedPods prints the logs of several pods, such as the pods of a workload or the pods
+// matching a label selector, from their default container unless opts selects containers.
+func logsSelectedPods(reader *mustgather.Reader, pods []unstructured.Unstructured, opts mustgather.PodLogOptions, printOpts printOptions) error {
+	opts.DefaultContainer = true
+	for _, pod := range pods {
+		containerLogs, err := reader.PodLogs(pod.GetNamespace(), pod.GetName(), opts)
 		if err != nil {
-			return fmt.Errorf("pod %s not found: %w", podName, err)
+			return err
 		}
-		// We create a Pod object and append it to the _Items PodList
-		var pod v1.Pod
-		if err := yaml.Unmarshal([]byte(_file), &pod); err != nil {
-			return fmt.Errorf("error unmarshaling %s: %w", podPath, err)
+		if err := printLogs(os.Stdout, containerLogs, printOpts); err != nil {
+			return err
 		}
-		_Items.Items = append(_Items.Items, pod)
-	} else if err := yaml.Unmarshal([]byte(_file), &_Items); err != nil {
-		return fmt.Errorf("error unmarshaling %s: %w", podsPath, err)
 	}
-	podMatch := ""
-	for _, Pod := range _Items.Items {
-		if podName != Pod.Name {
-			continue
+	return nil
+}
+
+// printLogs prints the logs of containers, each line prefixed by [pod/<pod>/<container>] with
+// prefix, as oc logs --prefix does.
+func printLogs(w io.Writer, containerLogs []mustgather.ContainerLog, printOpts printOptions) error {
+	for _, c := range containerLogs {
+		out := w
+		if printOpts.prefix {
+			out = &prefixWriter{w: w, prefix: []byte(fmt.Sprintf("[pod/%s/%s] ", c.Pod, c.Container))}
 		}
-		podMatch = podName
-		var containers []string
-		containerMatch := ""
-		if len(Pod.Spec.Containers) == 1 && containerName == "" {
-			containerMatch = Pod.Spec.Containers[0].Name
-		} else {
-			if allContainersFlag {
-				for _, c := range Pod.Spec.Containers {
-					log := NewLogReader(CurrentNamespacePath + "/pods/" + Pod.Name + "/" + c.Name + "/" + c.Name + "/logs")
-					log.WithFilter(logFilter)
-					log.WithTail(tail)
-					if previousFlag {
-						log.FromPrevious()
-					}
-					if rotatedFlag {
-						log.FromRotated()
-					}
-					if insecureFlag {
-						log.FromInsecure()
-					}
-					if err := log.Read(os.Stdout); err != nil {
-						return err
-					}
-				}
-				return nil
-			} else {
-				var containerSlice []v1.Container
-				containerSlice = append(containerSlice, Pod.Spec.Containers...)
-				containerSlice = append(containerSlice, Pod.Spec.InitContainers...)
-				for _, c := range containerSlice {
-					if containerName == c.Name {
-						containerMatch = containerName
-						break
-					}
-					containers = append(containers, c.Name)
-				}
-			}
+		log := NewContainerLogReader(c)
+		log.WithFilter(printOpts.filter)
+		log.WithTimeWindow(printOpts.window, CRITimestamp)
+		log.WithTail(printOpts.tail)
+		read := log.Read
+		if printOpts.allLogs {
+			read = log.ReadStitched
 		}
-		if containerMatch == "" {
-			for _, c := range Pod.Spec.InitContainers {
-				if containerName == c.Name {
-					containerMatch = containerName
-					break
-				}
-			}
+		if err := read(out); err != nil {
+			return err
 		}
-		if containerMatch == "" {
-			if containerName != "" {
-				return fmt.Errorf("container %s is not valid for pod %s", containerName, Pod.Name)
-			} else {
-				return fmt.Errorf("a container name must be specified for pod %s, choose one of: %v", Pod.Name, containers)
-			}
-		} else {
-			log := NewLogReader(CurrentNamespacePath + "/pods/" + Pod.Name + "/" + containerMatch + "/" + containerMatch + "/logs/")
-			log.WithFilter(logFilter)
-			log.WithTail(tail)
-			if previousFlag {
-				log.FromPrevious()
-			}
-			if rotatedFlag {
-				log.FromRotated()
-			}
-			if insecureFlag {
-				log.FromInsecure()
-			}
-			if err := log.Read(os.Stdout); err != nil {
-				return err
+	}
+	return nil
+}
+
+// prefixWriter writes a prefix at the start of each line.
+type prefixWriter struct {
+	w      io.Writer
+	prefix []byte
+	// midLine is set when the last write did not end a line.
+	midLine bool
+}
+
+func (p *prefixWriter) Write(b []byte) (int, error) {
+	written := 0
+	for len(b) > 0 {
+		if !p.midLine {
+			if _, err := p.w.Write(p.prefix); err != nil {
+				return written, err
 			}
+			p.midLine = true
 		}
+		line := b
+		if i := bytes.IndexByte(b, '\n'); i >= 0 {
+			line = b[:i+1]
+			p.midLine = false
+		}
+		n, err := p.w.Write(line)
+		written += n
+		if err != nil {
+			return written, err
+		}
+		b = b[len(line):]
 	}
-	if podMatch == "" {
-		return fmt.Errorf("pods %s not found", podName)
-	}
-	return nil
+	return written, nil
 }
@@ -0,0 +1,52 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package logs
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gmeghnag/omc/internal/testutil"
+	"github.com/gmeghnag/omc/pkg/mustgather"
+)
+
+func TestPrefixWriter(t *testing.T) {
+	var out bytes.Buffer
+	w := &prefixWriter{w: &out, prefix: []byte("[pod/p/c] ")}
+	for _, chunk := range []string{"first\nsec", "ond\n", "", "third\nfourth"} {
+		if _, err := w.Write([]byte(chunk)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if want := "[pod/p/c] first\n[pod/p/c] second\n[pod/p/c] third\n[pod/p/c] fourth"; out.String() != want {
+		t.Errorf("expected %q, got %q", want, out.String())
+	}
+}
+
+func TestPrintLogs(t *testing.T) {
+	dir := t.TempDir()
+	testutil.WriteFiles(t, dir, map[string]string{"web.log": "w1\nw2\n", "proxy.log": "p1\n"})
+	containerLogs := []mustgather.ContainerLog{
+		{Pod: "web-0", Container: "web", Dir: dir, Files: []string{"web.log"}},
+		{Pod: "web-0", Container: "proxy", Dir: dir, Files: []string{"proxy.log"}},
+	}
+	tests := []struct {
+		name   string
+		prefix bool
+		want   string
+	}{
+		{name: "unprefixed", want: "w1\nw2\np1\n"},
+		{name: "prefixed", prefix: true, want: "[pod/web-0/web] w1\n[pod/web-0/web] w2\n[pod/web-0/proxy] p1\n"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := printLogs(&out, containerLogs, printOptions{tail: -1, prefix: tc.prefix}); err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package logs
+
+import (
+	"container/heap"
+	"io"
+	"iter"
+	"time"
+)
+
+// stitch iterates over the lines of log files merged by their CRI timestamp, the files being
+// read in turn for lines logged at the same time. A line of a file logged at the same time and
+// with the same message as a line of another file, such as a line of previous.log also in a
+// rotated log, is dropped with the lines without timestamp continuing it. Only the current line
+// of each file is held in memory, the files being opened by openFile.
+func stitch(openFile func(string) (io.ReadCloser, error), dirname string, files []string) iter.Seq2[[]byte, error] {
+	return func(yield func([]byte, error) bool) {
+		h := &stitchHeads{}
+		defer func() {
+			for _, head := range *h {
+				head.stop()
+			}
+		}()
+		for i, filename := range files {
+			next, stop := iter.Pull2(fileLines(openFile, dirname, filename))
+			head := &stitchHead{index: i, next: next, stop: stop}
+			ok, err := head.advance()
+			if err != nil {
+				stop()
+				yield(nil, err)
+				return
+			}
+			if !ok {
+				stop()
+				continue
+			}
+			heap.Push(h, head)
+		}
+		var last time.Time
+		// the number of times each message logged at the last time was read from each file
+		seen := map[string]map[int]int{}
+		for h.Len() > 0 {
+			head := (*h)[0]
+			if !head.time.Equal(last) {
+				last = head.time
+				clear(seen)
+			}
+			message := string(CRIMessage(head.line))
+			if seen[message] == nil {
+				seen[message] = map[int]int{}
+			}
+			seen[message][head.index]++
+			// a message repeated in a file is only a duplicate if another file logged it as often
+			duplicate := false
+			for index, count := range seen[message] {
+				if index != head.index && count >= seen[message][head.index] {
+					duplicate = true
+				}
+			}
+			for {
+				if !duplicate && !yield(head.line, nil) {
+					return
+				}
+				ok, err := head.advance()
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+				if !ok {
+					head.stop()
+					heap.Pop(h)
+					break
+				}
+				if !head.continued {
+					heap.Fix(h, 0)
+					break
+				}
+			}
+		}
+	}
+}
+
+// stitchHead is the current line of a file being stitched.
+type stitchHead struct {
+	// index orders the files for the lines logged at the same time
+	index int
+	line  []byte
+	time  time.Time
+	// continued is set for the lines without timestamp, which keep the time of the line
+	// before them
+	continued bool
+	next      func() ([]byte, error, bool)
+	stop      func()
+}
+
+func (h *stitchHead) advance() (bool, error) {
+	line, err, ok := h.next()
+	if !ok {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	h.line = line
+	t, ok := CRITimestamp(line)
+	h.continued = !ok
+	if ok {
+		h.time = t
+	}
+	return true, nil
+}
+
+// stitchHeads is a min-heap of the heads of the files, by time.
+type stitchHeads []*stitchHead
+
+func (h stitchHeads) Len() int { return len(h) }
+
+func (h stitchHeads) Less(i, j int) bool {
+	if c := h[i].time.Compare(h[j].time); c != 0 {
+		return c < 0
+	}
+	return h[i].index < h[j].index
+}
+
+func (h stitchHeads) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *stitchHeads) Push(x any) { *h = append(*h, x.(*stitchHead)) }
+
+func (h *stitchHeads) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package logs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/gmeghnag/omc/internal/testutil"
+	"github.com/gmeghnag/omc/pkg/mustgather"
+)
+
+func TestReadStitched(t *testing.T) {
+	dir := t.TempDir()
+	var rotated bytes.Buffer
+	gz := gzip.NewWriter(&rotated)
+	gz.Write([]byte("2026-10-01T10:00:01.000000000+00:00 stderr F first\n" +
+		"2026-10-01T10:00:02.000000000+00:00 stderr F panic: crashed\n" +
+		"2026-10-01T10:00:02.000000000+00:00 stderr F retry\n"))
+	gz.Close()
+	files := map[string]string{
+		"rotated/0.log.20261001-100002.gz": rotated.String(),
+		"rotated/1.log.20261001-100010":    "2026-10-01T10:00:10.000000000+00:00 stdout F restarted\n",
+		// previous.log repeats the end of the rotated log of the previous instance
+		"previous.log": "2026-10-01T10:00:02Z panic: crashed\n2026-10-01T10:00:02Z retry\n2026-10-01T10:00:02Z retry\n2026-10-01T10:00:03Z exiting\n",
+		"current.log":  "2026-10-01T10:00:10Z restarted\n2026-10-01T10:00:11Z ready\n",
+	}
+	testutil.WriteFiles(t, dir, files)
+	all := mustgather.LogFiles(dir, mustgather.PodLogOptions{AllLogs: true})
+	tests := []struct {
+		name string
+		tail int64
+		want string
+	}{
+		{
+			name: "stitched",
+			tail: -1,
+			want: "2026-10-01T10:00:01.000000000+00:00 stderr F first\n" +
+				"2026-10-01T10:00:02.000000000+00:00 stderr F panic: crashed\n" +
+				"2026-10-01T10:00:02.000000000+00:00 stderr F retry\n" +
+				// the line repeated in previous.log is only a duplicate once
+				"2026-10-01T10:00:02Z retry\n" +
+				"2026-10-01T10:00:03Z exiting\n" +
+				"2026-10-01T10:00:10.000000000+00:00 stdout F restarted\n" +
+				"2026-10-01T10:00:11Z ready\n",
+		},
+		{name: "tail of the stitched logs", tail: 3, want: "2026-10-01T10:00:03Z exiting\n2026-10-01T10:00:10.000000000+00:00 stdout F restarted\n2026-10-01T10:00:11Z ready\n"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			log := &LogReader{dir, &all, nil, tc.tail, false}
+			var out bytes.Buffer
+			if err := log.ReadStitched(&out); err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != tc.want {
+				t.Errorf("expected:\n%s\ngot:\n%s", tc.want, out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package logs
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+)
+
+// TimeWindow selects the log lines logged between Since and Until, both included; a zero
+// bound leaves the window open on its side.
+type TimeWindow struct {
+	Since time.Time
+	Until time.Time
+}
+
+// NewTimeWindow returns the window of the --since, --since-time and --until-time flags. As
+// must-gathers are read after the fact, since is relative to now, the time of the gathering,
+// rather than to the current time.
+func NewTimeWindow(since time.Duration, sinceTime string, untilTime string, now time.Time) (TimeWindow, error) {
+	var w TimeWindow
+	if since != 0 && sinceTime != "" {
+		return w, fmt.Errorf("at most one of --since or --since-time may be specified")
+	}
+	if since < 0 {
+		return w, fmt.Errorf("--since must be a positive duration, got %s", since)
+	}
+	if since > 0 {
+		w.Since = now.Add(-since)
+	}
+	if sinceTime != "" {
+		t, err := time.Parse(time.RFC3339Nano, sinceTime)
+		if err != nil {
+			return w, fmt.Errorf("invalid --since-time %q: expected an RFC3339 time as 2006-01-02T15:04:05Z", sinceTime)
+		}
+		w.Since = t
+	}
+	if untilTime != "" {
+		t, err := time.Parse(time.RFC3339Nano, untilTime)
+		if err != nil {
+			return w, fmt.Errorf("invalid --until-time %q: expected an RFC3339 time as 2006-01-02T15:04:05Z", untilTime)
+		}
+		w.Until = t
+	}
+	if !w.Since.IsZero() && !w.Until.IsZero() && w.Until.Before(w.Since) {
+		return w, fmt.Errorf("--until-time %s is before the start of the window %s", w.Until.Format(time.RFC3339), w.Since.Format(time.RFC3339))
+	}
+	return w, nil
+}
+
+// IsZero returns whether the window is open on both sides, selecting every line.
+func (w TimeWindow) IsZero() bool {
+	return w.Since.IsZero() && w.Until.IsZero()
+}
+
+// Contains returns whether a time is part of the window.
+func (w TimeWindow) Contains(t time.Time) bool {
+	return (w.Since.IsZero() || !t.Before(w.Since)) && (w.Until.IsZero() || !t.After(w.Until))
+}
+
+// TimestampParser returns the time a log line was logged at, or false if it has none.
+type TimestampParser func(line []byte) (time.Time, bool)
+
+// CRITimestamp parses the timestamp of the lines of container logs written by CRI-O:
+// 2023-11-02T06:12:08.604741676Z stderr F message.
+func CRITimestamp(line []byte) (time.Time, bool) {
+	idx := bytes.IndexByte(line, ' ')
+	if idx < 0 {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(timeFormatIn, string(line[:idx]))
+	return t, err == nil
+}
+
+// JournalTimestamp returns a parser of the timestamp of the lines of journal logs, as gathered
+// in host_service_logs: Nov 02 06:12:08.604741 master-0 kubenswrapper[2345]: message.
+// The timestamps have no year, the one of the last such time until now is used.
+func JournalTimestamp(now time.Time) TimestampParser {
+	now = now.UTC()
+	return func(line []byte) (time.Time, bool) {
+		// the timestamp is made of the first three fields
+		end := 0
+		for fields := 0; fields < 3; fields++ {
+			for end < len(line) && line[end] == ' ' {
+				end++
+			}
+			idx := bytes.IndexByte(line[end:], ' ')
+			if idx < 0 {
+				return time.Time{}, false
+			}
+			end += idx
+		}
+		t, err := time.Parse("Jan _2 15:04:05", string(line[:end]))
+		if err != nil {
+			return time.Time{}, false
+		}
+		t = t.AddDate(now.Year(), 0, 0)
+		if t.After(now) {
+			t = t.AddDate(-1, 0, 0)
+		}
+		return t, true
+	}
+}
+
+// timeWindowFilter filters out the lines logged outside of a time window. The lines without a
+// timestamp, such as the continuation of a multi-line message, follow the line before them.
+type timeWindowFilter struct {
+	window   TimeWindow
+	parse    TimestampParser
+	inWindow bool
+}
+
+// NewTimeWindowFilter returns a filter of the lines logged in a window, or nil if the window is
+// open on both sides.
+func NewTimeWindowFilter(window TimeWindow, parse TimestampParser) logLineFilter {
+	if window.IsZero() {
+		return nil
+	}
+	return &timeWindowFilter{window: window, parse: parse}
+}
+
+func (f *timeWindowFilter) filterLogLine(log []byte) ([]byte, error) {
+	if t, ok := f.parse(log); ok {
+		f.inWindow = f.window.Contains(t)
+	}
+	if f.inWindow {
+		return log, nil
+	}
+	return []byte{}, nil
+}
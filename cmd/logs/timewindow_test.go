@@ -0,0 +1,109 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package logs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gmeghnag/omc/internal/testutil"
+)
+
+func TestNewTimeWindow(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		since     time.Duration
+		sinceTime string
+		untilTime string
+		want      TimeWindow
+		wantErr   string
+	}{
+		{name: "open"},
+		{name: "since is relative to the gathering", since: 10 * time.Minute, want: TimeWindow{Since: now.Add(-10 * time.Minute)}},
+		{
+			name:      "since-time and until-time",
+			sinceTime: "2026-10-01T10:00:00Z",
+			untilTime: "2026-10-01T12:15:00+02:00",
+			want:      TimeWindow{Since: time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC), Until: time.Date(2026, 10, 1, 10, 15, 0, 0, time.UTC)},
+		},
+		{name: "since and since-time", since: time.Minute, sinceTime: "2026-10-01T10:00:00Z", wantErr: "at most one of --since or --since-time"},
+		{name: "invalid since-time", sinceTime: "2026-10-01 10:00", wantErr: "invalid --since-time"},
+		{name: "until before since", sinceTime: "2026-10-01T10:00:00Z", untilTime: "2026-10-01T09:00:00Z", wantErr: "is before the start of the window"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := NewTimeWindow(tc.since, tc.sinceTime, tc.untilTime, now)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Since.Equal(tc.want.Since) || !got.Until.Equal(tc.want.Until) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestJournalTimestamp(t *testing.T) {
+	parse := JournalTimestamp(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		line string
+		want time.Time
+		ok   bool
+	}{
+		{line: "Jan 01 06:12:08.604741 master-0 kubenswrapper[2345]: I0101 message", want: time.Date(2026, 1, 1, 6, 12, 8, 604741000, time.UTC), ok: true},
+		{line: "Dec 31 23:59:59 master-0 crio[12]: message from the year before", want: time.Date(2025, 12, 31, 23, 59, 59, 0, time.UTC), ok: true},
+		{line: "Jan  1 00:00:01 master-0 crio[12]: message", want: time.Date(2026, 1, 1, 0, 0, 1, 0, time.UTC), ok: true},
+		{line: "\tcontinuation of a message"},
+	}
+	for _, tc := range tests {
+		got, ok := parse([]byte(tc.line))
+		if ok != tc.ok || !got.Equal(tc.want) {
+			t.Errorf("%q: expected %v %v, got %v %v", tc.line, tc.want, tc.ok, got, ok)
+		}
+	}
+}
+
+func TestReadWithTimeWindow(t *testing.T) {
+	dir := t.TempDir()
+	var gzipped bytes.Buffer
+	gz := gzip.NewWriter(&gzipped)
+	gz.Write([]byte("2026-10-01T09:00:00Z stdout F before\n2026-10-01T10:00:00Z stdout F rotated\n"))
+	gz.Close()
+	files := map[string]string{
+		"rotated/0.log.20261001-100000.gz": gzipped.String(),
+		"current.log":                      "2026-10-01T10:10:00Z stdout F current\n  continuation\n2026-10-01T10:20:00Z stdout F after\n  continuation\n",
+	}
+	testutil.WriteFiles(t, dir, files)
+	window := TimeWindow{Since: time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC), Until: time.Date(2026, 10, 1, 10, 15, 0, 0, time.UTC)}
+	tests := []struct {
+		name string
+		tail int64
+		want string
+	}{
+		{name: "window", tail: -1, want: "2026-10-01T10:00:00Z stdout F rotated\n2026-10-01T10:10:00Z stdout F current\n  continuation\n"},
+		{name: "tail of the window", tail: 1, want: "  continuation\n"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			log := &LogReader{dir, &[]string{"rotated/0.log.20261001-100000.gz", "current.log"}, nil, tc.tail, false}
+			log.WithTimeWindow(window, CRITimestamp)
+			var out bytes.Buffer
+			if err := log.Read(&out); err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package logs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// condition compares a field of a log line to a value.
+type condition struct {
+	field    string
+	operator string
+	value    string
+}
+
+// the operators are matched in order, the longest first
+var operators = []string{"!=", "<=", ">=", "=", "<", ">"}
+
+// whereFilter keeps the lines whose fields match all of its conditions. The fields are the keys
+// of the JSON structured lines, nested keys being joined by dots (e.g. err.code), or the
+// header of the lines logged by klog: level, file, line, caller (file:line) and thread.
+type whereFilter struct {
+	conditions []condition
+}
+
+// NewWhereFilter returns a filter of the lines matching all the comma separated conditions of
+// the expressions, as field=value, field!=value or a numeric comparison as line>=400. It is nil
+// without expressions.
+func NewWhereFilter(expressions []string) (logLineFilter, error) {
+	var f whereFilter
+	for _, expression := range expressions {
+		for _, c := range strings.Split(expression, ",") {
+			if strings.TrimSpace(c) == "" {
+				continue
+			}
+			parsed, err := parseCondition(c)
+			if err != nil {
+				return nil, fmt.Errorf("invalid --where %q: %w", expression, err)
+			}
+			f.conditions = append(f.conditions, parsed)
+		}
+	}
+	if len(f.conditions) == 0 {
+		return nil, nil
+	}
+	return &f, nil
+}
+
+func parseCondition(c string) (condition, error) {
+	idx := strings.IndexAny(c, "!=<>")
+	if idx <= 0 {
+		return condition{}, fmt.Errorf("expected a condition as field=value, got %q", c)
+	}
+	for _, operator := range operators {
+		if value, ok := strings.CutPrefix(c[idx:], operator); ok {
+			parsed := condition{field: strings.TrimSpace(c[:idx]), operator: operator, value: strings.TrimSpace(value)}
+			if operator != "=" && operator != "!=" {
+				if _, err := strconv.ParseFloat(parsed.value, 64); err != nil {
+					return condition{}, fmt.Errorf("%s expects a number, got %q", operator, parsed.value)
+				}
+			}
+			return parsed, nil
+		}
+	}
+	return condition{}, fmt.Errorf("expected a condition as field=value, got %q", c)
+}
+
+func (f *whereFilter) filterLogLine(log []byte) ([]byte, error) {
+	fields := logFields(CRIMessage(log))
+	for _, c := range f.conditions {
+		value, ok := fields[c.field]
+		if !c.matches(value, ok) {
+			return []byte{}, nil
+		}
+	}
+	return log, nil
+}
+
+// matches returns whether the value of a field matches the condition, the missing fields only
+// matching !=. The levels are compared by severity, e.g. warn matching W and warning.
+func (c condition) matches(value string, ok bool) bool {
+	if !ok {
+		return c.operator == "!="
+	}
+	switch c.operator {
+	case "=", "!=":
+		equal := strings.EqualFold(value, c.value)
+		if c.field == "level" || c.field == "severity" {
+			equal = normalizeLevel(value) == normalizeLevel(c.value)
+		}
+		return equal == (c.operator == "=")
+	}
+	x, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false
+	}
+	y, _ := strconv.ParseFloat(c.value, 64)
+	switch c.operator {
+	case "<":
+		return x < y
+	case "<=":
+		return x <= y
+	case ">":
+		return x > y
+	}
+	return x >= y
+}
+
+func normalizeLevel(level string) string {
+	switch strings.ToLower(level) {
+	case "i", "info":
+		return "info"
+	case "w", "warn", "warning":
+		return "warning"
+	case "e", "err", "error":
+		return "error"
+	case "f", "fatal", "panic":
+		return "fatal"
+	}
+	return strings.ToLower(level)
+}
+
+// logFields returns the fields of a JSON structured message or of the header of a klog one.
+func logFields(message []byte) map[string]string {
+	if trimmed := bytes.TrimSpace(message); len(trimmed) > 0 && trimmed[0] == '{' {
+		var object map[string]interface{}
+		if err := json.Unmarshal(trimmed, &object); err == nil {
+			fields := map[string]string{}
+			flatten(fields, "", object)
+			return fields
+		}
+	}
+	if header, ok := parseKlogHeader(message); ok {
+		return map[string]string{
+			"level":  klogLevels[header.severity],
+			"file":   header.file,
+			"line":   strconv.Itoa(header.line),
+			"caller": header.file + ":" + strconv.Itoa(header.line),
+			"thread": header.thread,
+		}
+	}
+	return nil
+}
+
+// flatten adds the values of an object to fields, nested keys being joined by dots.
+func flatten(fields map[string]string, prefix string, object map[string]interface{}) {
+	for key, value := range object {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			flatten(fields, prefix+key+".", v)
+		case string:
+			fields[prefix+key] = v
+		case nil:
+			fields[prefix+key] = "null"
+		default:
+			data, _ := json.Marshal(v)
+			fields[prefix+key] = string(data)
+		}
+	}
+}
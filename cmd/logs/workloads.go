@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package logs
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gmeghnag/omc/pkg/mustgather"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	deployments  = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	daemonSets   = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "daemonsets"}
+	statefulSets = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"}
+	replicaSets  = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}
+	jobs         = schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}
+)
+
+// workloadResources maps the TYPE of the TYPE/NAME arguments, other than pods, to their resource.
+var workloadResources = map[string]schema.GroupVersionResource{
+	"deploy": deployments, "deployment": deployments, "deployments": deployments, "deployments.apps": deployments,
+	"ds": daemonSets, "daemonset": daemonSets, "daemonsets": daemonSets, "daemonsets.apps": daemonSets,
+	"sts": statefulSets, "statefulset": statefulSets, "statefulsets": statefulSets, "statefulsets.apps": statefulSets,
+	"rs": replicaSets, "replicaset": replicaSets, "replicasets": replicaSets, "replicasets.apps": replicaSets,
+	"job": jobs, "jobs": jobs, "jobs.batch": jobs,
+}
+
+// isPodResource returns whether the TYPE of a TYPE/NAME argument names pods.
+func isPodResource(resource string) bool {
+	return resource == "po" || resource == "pod" || resource == "pods"
+}
+
+// logsWorkload prints the logs of a pod of a workload, the first running one, or of all its
+// pods with allPods.
+func logsWorkload(reader *mustgather.Reader, gvr schema.GroupVersionResource, namespace string, name string, allPods bool, opts mustgather.PodLogOptions, printOpts printOptions) error {
+	pods, err := reader.WorkloadPods(gvr, namespace, name)
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("%s %s not found", gvr.Resource, name)
+	}
+	if err != nil {
+		return err
+	}
+	if len(pods) == 0 {
+		return fmt.Errorf("no pods found for %s %s", gvr.Resource, name)
+	}
+	if !allPods && len(pods) > 1 {
+		pod := pods[0]
+		for _, p := range pods {
+			if phase, _, _ := unstructured.NestedString(p.Object, "status", "phase"); phase == "Running" {
+				pod = p
+				break
+			}
+		}
+		fmt.Fprintf(os.Stderr, "Found %d pods, using pod/%s\n", len(pods), pod.GetName())
+		pods = []unstructured.Unstructured{pod}
+	}
+	return logsSelectedPods(reader, pods, opts, printOpts)
+}
+
+// logsSelector prints the logs of the pods matching a label selector, in all namespaces if
+// namespace is empty.
+func logsSelector(reader *mustgather.Reader, namespace string, selector string, opts mustgather.PodLogOptions, printOpts printOptions) error {
+	pods, err := reader.List(schema.GroupVersionResource{Version: "v1", Resource: "pods"}, namespace, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return err
+	}
+	if len(pods) == 0 {
+		if namespace == "" {
+			fmt.Fprintln(os.Stderr, "No resources found.")
+		} else {
+			fmt.Fprintf(os.Stderr, "No resources found in %s namespace.\n", namespace)
+		}
+		return nil
+	}
+	return logsSelectedPods(reader, pods, opts, printOpts)
+}
@@ -5,10 +5,10 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
-	"reflect"
 
-	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/pkg/tableprinter"
 	"github.com/gmeghnag/omc/types"
+	"github.com/gmeghnag/omc/vars"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -34,20 +34,17 @@ var GetMustGather = &cobra.Command{
 		omcConfigJson := types.Config{}
 		_ = json.Unmarshal([]byte(file), &omcConfigJson)
 
-		var data [][]string
-		var emptyData [][]string
-		headers := []string{"current", "id", "path", "namespace"}
+		table := tableprinter.New(tableprinter.Options{Output: vars.OutputStringVar}, "CURRENT", "ID", "PATH", "NAMESPACE")
 		var mg []types.Context
 		mg = omcConfigJson.Contexts
 		for _, context := range mg {
-			_list := []string{context.Current, context.Id, context.Path, context.Project}
-			data = append(data, _list)
+			table.Append(context.Current, context.Id, context.Path, context.Project)
 		}
-		if reflect.DeepEqual(data, emptyData) {
+		if table.Len() == 0 {
 			fmt.Fprintln(os.Stderr, "There are no must-gather resources defined.")
 			os.Exit(1)
 		} else {
-			helpers.PrintTable(headers, data)
+			table.Print(os.Stdout)
 		}
 	},
 }
@@ -58,4 +55,5 @@ func init() {
 		GetMustGather,
 		DeleteCmd,
 	)
+	GetMustGather.Flags().StringVarP(&vars.OutputStringVar, "output", "o", "", "Output format. One of: csv|tsv")
 }
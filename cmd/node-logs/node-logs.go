@@ -4,11 +4,18 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
+	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/cmd/logs"
 	"github.com/gmeghnag/omc/vars"
 	"github.com/spf13/cobra"
 )
 
+var since time.Duration
+
+var sinceTime, untilTime string
+
 var NodeLogs = &cobra.Command{
 	Use:   "node-logs",
 	Short: "Display and filter node logs.",
@@ -27,12 +34,29 @@ var NodeLogs = &cobra.Command{
 			os.Exit(1)
 		}
 		if len(args) == 1 {
-			text, err := os.ReadFile(vars.MustGatherRootPath + "/host_service_logs/masters/" + args[0] + "_service.log")
+			now := helpers.MustGatherTime(vars.MustGatherRootPath)
+			window, err := logs.NewTimeWindow(since, sinceTime, untilTime, now)
 			if err != nil {
+				fmt.Fprintln(os.Stderr, "error:", err)
+				os.Exit(1)
+			}
+			filename := vars.MustGatherRootPath + "/host_service_logs/masters/" + args[0] + "_service.log"
+			if _, err := os.Stat(filename); err != nil {
 				fmt.Fprintln(os.Stderr, "logs for service \""+args[0]+"\" not found or readable.")
 				os.Exit(1)
 			}
-			fmt.Print(string(text))
+			log := logs.NewFileLogReader(filename)
+			log.WithTimeWindow(window, logs.JournalTimestamp(now))
+			if err := log.Read(os.Stdout); err != nil {
+				fmt.Fprintln(os.Stderr, "error:", err)
+				os.Exit(1)
+			}
 		}
 	},
 }
+
+func init() {
+	NodeLogs.Flags().DurationVar(&since, "since", 0, "Only return logs newer than a relative duration like 5s, 2m, or 3h, before the must-gather was collected. Only one of since-time / since may be used.")
+	NodeLogs.Flags().StringVar(&sinceTime, "since-time", "", "Only return logs after a specific date (RFC3339). Only one of since-time / since may be used.")
+	NodeLogs.Flags().StringVar(&untilTime, "until-time", "", "Only return logs before a specific date (RFC3339).")
+}
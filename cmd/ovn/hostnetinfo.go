@@ -21,6 +21,7 @@ import (
 	"slices"
 
 	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/pkg/tableprinter"
 	"github.com/gmeghnag/omc/vars"
 
 	"strings"
@@ -153,7 +154,7 @@ var HostnetinfoCmd = &cobra.Command{
 				}
 			}
 
-			if vars.OutputStringVar == "wide" {
+			if vars.OutputStringVar == "wide" || tableprinter.IsDelimited(vars.OutputStringVar) {
 				nodeEncapIP := ""
 				nodeEncapIPs := Node.ObjectMeta.Annotations["k8s.ovn.org/node-encap-ips"]
 				if nodeEncapIPs != "" {
@@ -175,7 +176,9 @@ var HostnetinfoCmd = &cobra.Command{
 			data = append(data, row)
 
 		}
-		helpers.PrintTable(headers, data)
+		table := tableprinter.New(tableprinter.Options{Output: vars.OutputStringVar}, headers...)
+		table.AppendRows(data)
+		table.Print(os.Stdout)
 	},
 }
 
@@ -196,5 +199,5 @@ type Config struct {
 }
 
 func init() {
-	HostnetinfoCmd.Flags().StringVarP(&vars.OutputStringVar, "output", "o", "", "Output format: wide.")
+	HostnetinfoCmd.Flags().StringVarP(&vars.OutputStringVar, "output", "o", "", "Output format. One of: wide|csv|tsv.")
 }
@@ -21,6 +21,7 @@ import (
 	"slices"
 
 	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/pkg/tableprinter"
 	"github.com/gmeghnag/omc/vars"
 
 	"strings"
@@ -83,7 +84,7 @@ var NodeExtraInfoCmd = &cobra.Command{
 				}
 			}
 
-			if vars.OutputStringVar == "wide" {
+			if vars.OutputStringVar == "wide" || tableprinter.IsDelimited(vars.OutputStringVar) {
 
 				nodeZoneName := Node.ObjectMeta.Annotations["k8s.ovn.org/zone-name"]
 				if nodeZoneName != "" {
@@ -106,10 +107,12 @@ var NodeExtraInfoCmd = &cobra.Command{
 			data = append(data, row)
 
 		}
-		helpers.PrintTable(headers, data)
+		table := tableprinter.New(tableprinter.Options{Output: vars.OutputStringVar}, headers...)
+		table.AppendRows(data)
+		table.Print(os.Stdout)
 	},
 }
 
 func init() {
-	NodeExtraInfoCmd.Flags().StringVarP(&vars.OutputStringVar, "output", "o", "", "Output format: wide.")
+	NodeExtraInfoCmd.Flags().StringVarP(&vars.OutputStringVar, "output", "o", "", "Output format. One of: wide|csv|tsv.")
 }
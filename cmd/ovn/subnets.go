@@ -21,6 +21,7 @@ import (
 	"slices"
 
 	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/pkg/tableprinter"
 	"github.com/gmeghnag/omc/vars"
 
 	"strings"
@@ -135,7 +136,7 @@ var SubnetsCmd = &cobra.Command{
 				}
 			}
 
-			if vars.OutputStringVar == "wide" {
+			if vars.OutputStringVar == "wide" || tableprinter.IsDelimited(vars.OutputStringVar) {
 
 				nodeMasqueradeSubnet := ""
 				nodeMasqueradeSubnetStrMap := Node.ObjectMeta.Annotations["k8s.ovn.org/node-masquerade-subnet"]
@@ -160,10 +161,12 @@ var SubnetsCmd = &cobra.Command{
 			data = append(data, row)
 
 		}
-		helpers.PrintTable(headers, data)
+		table := tableprinter.New(tableprinter.Options{Output: vars.OutputStringVar}, headers...)
+		table.AppendRows(data)
+		table.Print(os.Stdout)
 	},
 }
 
 func init() {
-	SubnetsCmd.Flags().StringVarP(&vars.OutputStringVar, "output", "o", "", "Output format: wide.")
+	SubnetsCmd.Flags().StringVarP(&vars.OutputStringVar, "output", "o", "", "Output format. One of: wide|csv|tsv.")
 }
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/pkg/tableprinter"
 	"github.com/gmeghnag/omc/vars"
 
 	"github.com/spf13/cobra"
@@ -30,7 +31,7 @@ import (
 )
 
 func GetAlertGroups(resourcesNames []string, outputFlag string, groupFile string, alertsFilePath string) {
-	_headers := []string{"group", "filename", "age"}
+	_headers := []string{"GROUP", "FILENAME", "AGE"}
 	var data [][]string
 	var filteredGroups []RuleGroup
 	var _Alerts alerts
@@ -65,13 +66,13 @@ func GetAlertGroups(resourcesNames []string, outputFlag string, groupFile string
 		data = helpers.GetData(data, true, false, "", "", 3, _list)
 	}
 
-	var headers []string
-	if outputFlag == "" || outputFlag == "wide" {
-		headers = _headers[0:3]
+	if outputFlag == "" || outputFlag == "wide" || tableprinter.IsDelimited(outputFlag) {
 		if len(data) == 0 {
 			fmt.Println("No alertgroups found.")
 		} else {
-			helpers.PrintTable(headers, data)
+			table := tableprinter.New(tableprinter.Options{Output: outputFlag}, _headers...)
+			table.AppendRows(data)
+			table.Print(os.Stdout)
 		}
 	}
 	if outputFlag == "yaml" {
@@ -114,5 +115,5 @@ var GroupSubCmd = &cobra.Command{
 
 func init() {
 	GroupSubCmd.Flags().StringVarP(&GroupFilename, "filename", "f", "", "Filter the AlertGroup by filename.")
-	GroupSubCmd.Flags().StringVarP(&vars.OutputStringVar, "output", "o", "", "Output format. One of: json|yaml")
+	GroupSubCmd.Flags().StringVarP(&vars.OutputStringVar, "output", "o", "", "Output format. One of: json|yaml|csv|tsv")
 }
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/pkg/tableprinter"
 	"github.com/gmeghnag/omc/vars"
 
 	"github.com/spf13/cobra"
@@ -32,7 +33,7 @@ import (
 )
 
 func GetAlertRules(resourcesNames []string, outputFlag string, groupsNames string, rulesStates string, alertsFilePath string) {
-	_headers := []string{"group", "rule", "severity", "state", "age", "alerts", "active since"}
+	_headers := []string{"GROUP", "RULE", "SEVERITY", "STATE", "AGE", "ALERTS", "ACTIVE SINCE"}
 	var data [][]string
 	var filteredRules []Rule
 	var filteredRulesList FilteredRulesList
@@ -112,28 +113,24 @@ func GetAlertRules(resourcesNames []string, outputFlag string, groupsNames strin
 			lastEval := helpers.FormatDiffTime(d)
 			_list := []string{group.Name, ruleName, ruleSeverity, ruleState, lastEval, numAlerts, activeSince}
 			showGroup := false
-			if outputFlag == "wide" {
+			if outputFlag == "wide" || tableprinter.IsDelimited(outputFlag) {
 				showGroup = true
 			}
 			data = helpers.GetData(data, showGroup, false, "", outputFlag, 7, _list)
 		}
 	}
 
-	var headers []string
-	if outputFlag == "" {
-		headers = _headers[1:]
-		if len(data) == 0 {
-			fmt.Fprintf(os.Stderr, "No resources found.\n")
-		} else {
-			helpers.PrintTable(headers, data)
+	if outputFlag == "" || outputFlag == "wide" || tableprinter.IsDelimited(outputFlag) || tableprinter.IsDocument(outputFlag) {
+		headers := _headers
+		if outputFlag == "" || tableprinter.IsDocument(outputFlag) {
+			headers = _headers[1:]
 		}
-	}
-	if outputFlag == "wide" {
-		headers = _headers[0:]
 		if len(data) == 0 {
 			fmt.Fprintf(os.Stderr, "No resources found.\n")
 		} else {
-			helpers.PrintTable(headers, data)
+			table := tableprinter.New(tableprinter.Options{Output: outputFlag}, headers...)
+			table.AppendRows(data)
+			table.Print(os.Stdout)
 		}
 	}
 	if outputFlag == "yaml" {
@@ -176,5 +173,5 @@ var RuleSubCmd = &cobra.Command{
 func init() {
 	RuleSubCmd.Flags().StringVarP(&GroupName, "group", "g", "", "Filter the AlertRules by AlertGroup/s (comma separated).")
 	RuleSubCmd.Flags().StringVarP(&RuleState, "state", "s", "", "Filter the AlertRules by state.")
-	RuleSubCmd.Flags().StringVarP(&vars.OutputStringVar, "output", "o", "", "Output format. One of: json|yaml")
+	RuleSubCmd.Flags().StringVarP(&vars.OutputStringVar, "output", "o", "", "Output format. One of: json|yaml|wide|csv|tsv|markdown|html")
 }
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/pkg/tableprinter"
 	"github.com/gmeghnag/omc/vars"
 
 	"github.com/spf13/cobra"
@@ -52,17 +53,17 @@ var TargetSubCmd = &cobra.Command{
 			fmt.Println(err)
 		}
 		headers := []string{"TARGET", "SCRAPE URL", "HEALTH", "LAST ERROR"}
-		var data [][]string
+		table := tableprinter.New(tableprinter.Options{Output: vars.OutputStringVar}, headers...)
 		for _, target := range targets.Data.ActiveTargets {
-			row := []string{target.DiscoveredLabels["__meta_kubernetes_endpoint_address_target_name"], target.ScrapeURL, target.Health, target.LastError}
-			data = append(data, row)
+			table.Append(target.DiscoveredLabels["__meta_kubernetes_endpoint_address_target_name"], target.ScrapeURL, target.Health, target.LastError)
 		}
-		helpers.PrintTable(headers, data)
+		table.Print(os.Stdout)
 	},
 }
 
 func init() {
 	TargetSubCmd.Flags().StringVarP(&PrometheusInstance, "instance", "i", "prometheus-k8s-0", "Show targets for specific prometheus instance, availables: [prometheus-k8s-0|prometheus-k8s-1].")
+	TargetSubCmd.Flags().StringVarP(&vars.OutputStringVar, "output", "o", "", "Output format. One of: csv|tsv")
 }
 
 // Target has the information for one target.
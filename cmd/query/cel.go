@@ -0,0 +1,174 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package query
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/ast"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"github.com/google/cel-go/ext"
+	"k8s.io/apiserver/pkg/cel/library"
+)
+
+// objectVariable is the name of the variable holding the object an expression is evaluated on.
+const objectVariable = "object"
+
+var (
+	envOnce sync.Once
+	env     *cel.Env
+	envErr  error
+)
+
+// celEnv returns the environment of the expressions: the CEL standard library with the string,
+// set and optional extensions and the list, regex and quantity libraries of Kubernetes, as in
+// the validation rules of the API server.
+func celEnv() (*cel.Env, error) {
+	envOnce.Do(func() {
+		env, envErr = cel.NewEnv(
+			cel.Variable(objectVariable, cel.DynType),
+			cel.OptionalTypes(),
+			ext.Strings(ext.StringsVersion(2)),
+			ext.Sets(),
+			library.Lists(),
+			library.Regex(),
+			library.Quantity(),
+		)
+	})
+	return env, envErr
+}
+
+// expr is a compiled CEL expression.
+type expr struct {
+	program cel.Program
+	// keys are the keys of the map built by the expression, in their order, or nil if the
+	// expression does not build a map.
+	keys []string
+}
+
+// compile compiles a CEL expression.
+func compile(expression string) (*expr, error) {
+	env, err := celEnv()
+	if err != nil {
+		return nil, err
+	}
+	checked, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	program, err := env.Program(checked)
+	if err != nil {
+		return nil, err
+	}
+	return &expr{program: program, keys: mapKeys(checked.NativeRep().Expr())}, nil
+}
+
+// eval evaluates the expression on a value and returns its result as a JSON like value.
+func (e *expr) eval(v any) (any, error) {
+	out, _, err := e.program.Eval(map[string]any{objectVariable: v})
+	if err != nil {
+		return nil, err
+	}
+	return native(out)
+}
+
+// mapKeys returns the keys of a map literal, if they are all strings.
+func mapKeys(e ast.Expr) []string {
+	if e.Kind() != ast.MapKind {
+		return nil
+	}
+	var keys []string
+	for _, entry := range e.AsMap().Entries() {
+		key := entry.AsMapEntry().Key()
+		if key.Kind() != ast.LiteralKind {
+			return nil
+		}
+		s, ok := key.AsLiteral().(types.String)
+		if !ok {
+			return nil
+		}
+		keys = append(keys, string(s))
+	}
+	return keys
+}
+
+// native converts a CEL value to the values of an unstructured object: nil, bool, int64,
+// float64, string, []any and map[string]any. Timestamps and durations are converted to their
+// string, as in the objects.
+func native(v ref.Val) (any, error) {
+	switch v := v.(type) {
+	case types.Null:
+		return nil, nil
+	case types.Bool:
+		return bool(v), nil
+	case types.Int:
+		return int64(v), nil
+	case types.Uint:
+		return int64(v), nil
+	case types.Double:
+		return float64(v), nil
+	case types.String:
+		return string(v), nil
+	case types.Bytes:
+		return string(v), nil
+	case types.Timestamp:
+		return v.Time.UTC().Format(time.RFC3339), nil
+	case types.Duration:
+		return v.Duration.String(), nil
+	case *types.Optional:
+		if !v.HasValue() {
+			return nil, nil
+		}
+		return native(v.GetValue())
+	case traits.Lister:
+		list := []any{}
+		for it := v.Iterator(); it.HasNext() == types.True; {
+			item, err := native(it.Next())
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, item)
+		}
+		return list, nil
+	case traits.Mapper:
+		m := map[string]any{}
+		for it := v.Iterator(); it.HasNext() == types.True; {
+			key := it.Next()
+			k, ok := key.(types.String)
+			if !ok {
+				return nil, fmt.Errorf("unsupported map key %v of type %s", key, key.Type())
+			}
+			value, err := native(v.Get(key))
+			if err != nil {
+				return nil, err
+			}
+			m[string(k)] = value
+		}
+		return m, nil
+	}
+	// other values, such as quantities, are printed as their string
+	s, err := v.ConvertToType(types.StringType).ConvertToNative(reflect.TypeOf(""))
+	if err != nil {
+		return nil, fmt.Errorf("unsupported value %v of type %s", v, v.Type())
+	}
+	return s, nil
+}
@@ -0,0 +1,369 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gmeghnag/omc/cmd/get"
+	"github.com/gmeghnag/omc/pkg/tableprinter"
+	"github.com/gmeghnag/omc/vars"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// options are the flags of "omc query".
+type options struct {
+	output    string
+	where     []string
+	groupBy   []string
+	count     bool
+	noHeaders bool
+}
+
+var opts options
+
+var QueryCmd = &cobra.Command{
+	Use:   "query <resource>[,<resource>...] [<expression>]",
+	Short: "Filter, project and aggregate objects with CEL expressions.",
+	Long: `Evaluate a CEL expression over each object that "omc get" would return for the same arguments,
+bound to the variable "object", and print the values it returns. The maps built by the
+expression ({"key": value, ...}) are printed with a column per key, the objects as their
+namespace, kind and name and other values in a single column.
+
+The objects are filtered beforehand by the --where expressions, which must all return true.
+The expressions have the CEL standard library, the string, set and optional extensions and the
+list, regex and quantity libraries of Kubernetes, as the validation rules of the API server.
+Accessing a missing field is an error: test it with has(object.a.b), or read it as an optional
+with object.?a.?b.orValue(default).
+
+The values can be grouped by one or more expressions with --group-by, each evaluated on the
+value bound to "object", printing the number of values of each group, and counted with --count.`,
+	Example: `  omc query pods -A --where 'object.status.?containerStatuses.orValue([]).exists(c, c.restartCount > 5)' '{"ns": object.metadata.namespace, "name": object.metadata.name}'
+  omc query pods -A --where 'object.status.phase != "Running"' --group-by object.metadata.namespace --group-by object.status.phase
+  omc query deployments,statefulsets -A --where 'object.spec.replicas != object.status.?readyReplicas.orValue(0)' -o csv
+  omc query nodes '{"name": object.metadata.name, "cpu": object.status.capacity.cpu, "ready": object.status.conditions.filter(c, c.type == "Ready")[0].status}'
+  omc query events -A --where 'object.type == "Warning"' --count`,
+	Args:         cobra.RangeArgs(1, 2),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if vars.MustGatherRootPath == "" {
+			return fmt.Errorf("there are no must-gather resources defined, use \"omc use\" first")
+		}
+		if opts.output != "table" && opts.output != "json" && !tableprinter.IsDelimited(opts.output) {
+			return fmt.Errorf("--output %s is not available, use one of: table|json|csv|tsv", opts.output)
+		}
+		expression := objectVariable
+		if len(args) == 2 {
+			expression = args[1]
+		}
+		objects, err := get.Objects(args[:1])
+		if err != nil {
+			return err
+		}
+		return query(os.Stdout, os.Stderr, objects, expression, opts)
+	},
+}
+
+func init() {
+	QueryCmd.Flags().BoolVarP(&vars.AllNamespaceBoolVar, "all-namespaces", "A", false, "If present, query the requested object(s) across all namespaces.")
+	QueryCmd.Flags().StringVarP(&vars.LabelSelectorStringVar, "selector", "l", "", "Selector (label query) to filter on, supports '=', '==', '!=', 'in', 'notin', 'key' and '!key'.(e.g. -l key1=value1,key2 in (value2,value3),!key3)")
+	QueryCmd.Flags().StringVar(&vars.FieldSelectorStringVar, "field-selector", "", "Selector (field query) to filter on, supports '=', '==', and '!='.(e.g. --field-selector key1=value1,key2=value2)")
+	QueryCmd.Flags().StringVarP(&opts.output, "output", "o", "table", "Output format. One of: table|json|csv|tsv.")
+	QueryCmd.Flags().StringArrayVar(&opts.where, "where", []string{}, "Only query the objects for which this CEL expression returns true, can be repeated for objects matching all the expressions.")
+	QueryCmd.Flags().StringArrayVar(&opts.groupBy, "group-by", []string{}, "Group the values by the values of a CEL expression and print the number of values of each group, can be repeated to group by several expressions.")
+	QueryCmd.Flags().BoolVar(&opts.count, "count", false, "Only print the number of values, or of groups with --group-by.")
+	QueryCmd.Flags().BoolVar(&opts.noHeaders, "no-headers", false, "When using the table, csv or tsv output format, don't print headers.")
+}
+
+// query evaluates an expression over the objects matching the where expressions, then groups,
+// counts and prints the values it returns.
+func query(w, errOut io.Writer, objects []unstructured.Unstructured, expression string, o options) error {
+	e, err := compile(expression)
+	if err != nil {
+		return fmt.Errorf("invalid expression %q: %w", expression, err)
+	}
+	var filters []*expr
+	for _, where := range o.where {
+		f, err := compile(where)
+		if err != nil {
+			return fmt.Errorf("invalid --where %q: %w", where, err)
+		}
+		filters = append(filters, f)
+	}
+	var values []any
+	for _, obj := range objects {
+		ok, err := matches(filters, o.where, obj.Object)
+		if err != nil {
+			return fmt.Errorf("%s: %w", objectName(obj.Object), err)
+		}
+		if !ok {
+			continue
+		}
+		value, err := e.eval(obj.Object)
+		if err != nil {
+			return fmt.Errorf("%s: %w", objectName(obj.Object), err)
+		}
+		values = append(values, value)
+	}
+	columns := e.keys
+	if len(o.groupBy) > 0 {
+		if values, columns, err = group(values, o.groupBy); err != nil {
+			return err
+		}
+	}
+	if o.count {
+		_, err := fmt.Fprintln(w, len(values))
+		return err
+	}
+	if o.output == "json" {
+		if values == nil {
+			values = []any{}
+		}
+		data, err := json.MarshalIndent(values, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	}
+	if len(values) == 0 && !tableprinter.IsDelimited(o.output) {
+		_, err := fmt.Fprintln(errOut, "No resources found.")
+		return err
+	}
+	headers, rows := toRows(values, columns)
+	for i := range headers {
+		headers[i] = strings.ToUpper(headers[i])
+	}
+	if !tableprinter.IsDelimited(o.output) {
+		for _, row := range rows {
+			for i := range row {
+				if row[i] == "" {
+					row[i] = "<none>"
+				}
+			}
+		}
+	}
+	table := tableprinter.New(tableprinter.Options{Output: o.output, NoHeaders: o.noHeaders}, headers...)
+	table.AppendRows(rows)
+	return table.Print(w)
+}
+
+// matches returns whether all the filters return true for an object.
+func matches(filters []*expr, expressions []string, obj map[string]any) (bool, error) {
+	for i, f := range filters {
+		value, err := f.eval(obj)
+		if err != nil {
+			return false, err
+		}
+		ok, isBool := value.(bool)
+		if !isBool {
+			return false, fmt.Errorf("--where %q returned %s, expected a bool", expressions[i], cell(value))
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// group returns a map per group of values, holding the values of the group expressions and the
+// number of values of the group, sorted by decreasing number then by group, and its columns.
+func group(values []any, expressions []string) ([]any, []string, error) {
+	type groupCount struct {
+		keys  []any
+		count int64
+	}
+	var exprs []*expr
+	columns := make([]string, len(expressions))
+	for i, expression := range expressions {
+		e, err := compile(expression)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid expression %q: %w", expression, err)
+		}
+		exprs = append(exprs, e)
+		columns[i] = columnName(expression)
+	}
+	groups := make(map[string]*groupCount)
+	var order []*groupCount
+	for _, v := range values {
+		var keys []any
+		for _, e := range exprs {
+			key, err := e.eval(v)
+			if err != nil {
+				return nil, nil, err
+			}
+			keys = append(keys, key)
+		}
+		id, err := json.Marshal(keys)
+		if err != nil {
+			return nil, nil, err
+		}
+		g, ok := groups[string(id)]
+		if !ok {
+			g = &groupCount{keys: keys}
+			groups[string(id)] = g
+			order = append(order, g)
+		}
+		g.count++
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		if order[i].count != order[j].count {
+			return order[i].count > order[j].count
+		}
+		for k := range order[i].keys {
+			if c := compare(order[i].keys[k], order[j].keys[k]); c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+	out := make([]any, len(order))
+	for i, g := range order {
+		m := map[string]any{"count": g.count}
+		for j, column := range columns {
+			m[column] = g.keys[j]
+		}
+		out[i] = m
+	}
+	return out, append(columns, "count"), nil
+}
+
+// compare orders two group keys: numbers by value, other values by their cell.
+func compare(a, b any) int {
+	x, xIsNumber := number(a)
+	y, yIsNumber := number(b)
+	if xIsNumber && yIsNumber {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(cell(a), cell(b))
+}
+
+// number returns the value of a number.
+func number(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+var simplePath = regexp.MustCompile(`^` + objectVariable + `(\.[A-Za-z_][A-Za-z0-9_]*)+$`)
+
+// columnName returns the last field of a field selection, as "namespace" for
+// object.metadata.namespace, or the expression itself.
+func columnName(expression string) string {
+	expression = strings.TrimSpace(expression)
+	if simplePath.MatchString(expression) {
+		return expression[strings.LastIndex(expression, ".")+1:]
+	}
+	return expression
+}
+
+// toRows returns the headers and the rows printing values: a column per key for the maps built
+// by the expression, the namespace, kind and name for API objects and a single column for any
+// other values.
+func toRows(values []any, columns []string) ([]string, [][]string) {
+	built, resources := columns != nil, true
+	for _, v := range values {
+		_, isMap := v.(map[string]any)
+		built = built && isMap
+		resources = resources && isResource(v)
+	}
+	var headers []string
+	var rows [][]string
+	switch {
+	case len(values) > 0 && built:
+		headers = append(headers, columns...)
+		for _, v := range values {
+			m := v.(map[string]any)
+			row := make([]string, len(columns))
+			for i, k := range columns {
+				row[i] = cell(m[k])
+			}
+			rows = append(rows, row)
+		}
+	case len(values) > 0 && resources:
+		headers = []string{"namespace", "kind", "name"}
+		for _, v := range values {
+			u := unstructured.Unstructured{Object: v.(map[string]any)}
+			rows = append(rows, []string{u.GetNamespace(), u.GetKind(), u.GetName()})
+		}
+	default:
+		headers = []string{"value"}
+		for _, v := range values {
+			rows = append(rows, []string{cell(v)})
+		}
+	}
+	return headers, rows
+}
+
+// isResource returns whether a value is an API object.
+func isResource(v any) bool {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return false
+	}
+	_, hasKind := m["kind"].(string)
+	_, hasMetadata := m["metadata"].(map[string]any)
+	return hasKind && hasMetadata
+}
+
+// cell formats a value for the table, csv and tsv outputs: strings as is, nested values as JSON.
+func cell(v any) string {
+	switch c := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return c
+	case int64:
+		return strconv.FormatInt(c, 10)
+	case float64:
+		return strconv.FormatFloat(c, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(c)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+// objectName names an object in errors, as Pod namespace/name.
+func objectName(obj map[string]any) string {
+	u := unstructured.Unstructured{Object: obj}
+	if u.GetNamespace() == "" {
+		return u.GetKind() + " " + u.GetName()
+	}
+	return u.GetKind() + " " + u.GetNamespace() + "/" + u.GetName()
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newPod(namespace, name, phase string, restarts ...int64) unstructured.Unstructured {
+	var statuses []interface{}
+	for i, r := range restarts {
+		statuses = append(statuses, map[string]interface{}{"name": "c" + string(rune('0'+i)), "restartCount": r})
+	}
+	status := map[string]interface{}{"phase": phase}
+	if statuses != nil {
+		status["containerStatuses"] = statuses
+	}
+	obj := unstructured.Unstructured{Object: map[string]interface{}{"status": status}}
+	obj.SetAPIVersion("v1")
+	obj.SetKind("Pod")
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetLabels(map[string]string{"app": name})
+	return obj
+}
+
+func TestCompile(t *testing.T) {
+	input := map[string]interface{}{
+		"name":   "web",
+		"count":  int64(3),
+		"items":  []interface{}{int64(1), int64(7), int64(2)},
+		"tags":   []interface{}{"a", "b"},
+		"nested": map[string]interface{}{"key": "value", "empty": nil},
+		"time":   "2026-01-02T03:04:05Z",
+		"memory": "512Mi",
+	}
+	tests := []struct {
+		expression string
+		want       string
+		wantErr    string
+	}{
+		{expression: "object", want: `{"count":3,"items":[1,7,2],"memory":"512Mi","name":"web","nested":{"empty":null,"key":"value"},"tags":["a","b"],"time":"2026-01-02T03:04:05Z"}`},
+		{expression: "object.name", want: `"web"`},
+		{expression: `object.nested["key"]`, want: `"value"`},
+		{expression: "object.items[1]", want: `7`},
+		{expression: "object.missing", wantErr: "no such key: missing"},
+		{expression: `has(object.missing) ? object.missing : "default"`, want: `"default"`},
+		{expression: `object.?missing.?field.orValue("default")`, want: `"default"`},
+		{expression: "object.?nested.?key", want: `"value"`},
+		{expression: "object.count * 2 + 1", want: `7`},
+		{expression: "double(object.count) / 2.0", want: `1.5`},
+		{expression: `object.name + "-" + object.nested.key`, want: `"web-value"`},
+		{expression: `object.count > 2 && object.name == "web"`, want: `true`},
+		{expression: `{"name": object.name, "n": object.count, "first": object.items[0]}`, want: `{"first":1,"n":3,"name":"web"}`},
+		{expression: "object.items.map(i, i * 10)", want: `[10,70,20]`},
+		{expression: "object.items.filter(i, i > 1)", want: `[7,2]`},
+		{expression: "object.items.exists(i, i > 5)", want: `true`},
+		{expression: "object.items.all(i, i > 1)", want: `false`},
+		{expression: "[size(object.items), object.items.sum(), object.items.min(), object.items.max()]", want: `[3,10,1,7]`},
+		{expression: "object.nested.size()", want: `2`},
+		{expression: `object.tags.join(",")`, want: `"a,b"`},
+		{expression: `sets.contains(object.tags, ["b"])`, want: `true`},
+		{expression: `[object.name.matches("^w"), object.name.startsWith("we"), object.name.endsWith("x"), object.name.upperAscii()]`, want: `[true,true,false,"WEB"]`},
+		{expression: `object.name.split("e")`, want: `["w","b"]`},
+		{expression: "timestamp(object.time) + duration('1h')", want: `"2026-01-02T04:04:05Z"`},
+		{expression: `timestamp(object.time) - timestamp("2026-01-02T03:00:00Z")`, want: `"4m5s"`},
+		{expression: `quantity(object.memory).isGreaterThan(quantity("256Mi"))`, want: `true`},
+		{expression: "string(object.count)", want: `"3"`},
+		{expression: "object.name ==", wantErr: "ERROR: <input>:1:15: Syntax error: mismatched input '<EOF>' expecting"},
+		{expression: "unknown(1)", wantErr: "ERROR: <input>:1:8: undeclared reference to 'unknown'"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.expression, func(t *testing.T) {
+			e, err := compile(tc.expression)
+			var value any
+			if err == nil {
+				value, err = e.eval(input)
+			}
+			if tc.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := json.Marshal(value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestQuery(t *testing.T) {
+	objects := []unstructured.Unstructured{
+		newPod("ns1", "a", "Running", 0, 7),
+		newPod("ns1", "b", "Pending"),
+		newPod("ns2", "c", "Running", 9),
+		newPod("ns2", "d", "Failed", 1),
+	}
+	tests := []struct {
+		name       string
+		expression string
+		opts       options
+		want       string
+		wantErr    string
+	}{
+		{
+			name:       "resources",
+			expression: "object",
+			opts:       options{output: "table"},
+			want:       "NAMESPACE   KIND   NAME\nns1         Pod    a\nns1         Pod    b\nns2         Pod    c\nns2         Pod    d\n",
+		},
+		{
+			name:       "projection",
+			expression: `{"ns": object.metadata.namespace, "name": object.metadata.name}`,
+			opts:       options{output: "table", where: []string{"object.status.?containerStatuses.orValue([]).exists(c, c.restartCount > 5)"}},
+			want:       "NS    NAME\nns1   a\nns2   c\n",
+		},
+		{
+			name:       "several filters",
+			expression: "object.metadata.name",
+			opts:       options{output: "table", where: []string{`object.status.phase != "Running"`, `object.metadata.namespace == "ns2"`}},
+			want:       "VALUE\nd\n",
+		},
+		{
+			name:       "values",
+			expression: "object.status.?containerStatuses.orValue([]).map(c, c.restartCount).sum()",
+			opts:       options{output: "table"},
+			want:       "VALUE\n7\n0\n9\n1\n",
+		},
+		{
+			name:       "group",
+			expression: "object",
+			opts:       options{output: "table", groupBy: []string{"object.status.phase"}},
+			want:       "PHASE     COUNT\nRunning   2\nFailed    1\nPending   1\n",
+		},
+		{
+			name:       "group by several expressions",
+			expression: `{"ns": object.metadata.namespace, "running": object.status.phase == "Running"}`,
+			opts:       options{output: "csv", groupBy: []string{"object.ns", "!object.running"}},
+			want:       "NS,!OBJECT.RUNNING,COUNT\nns1,false,1\nns1,true,1\nns2,false,1\nns2,true,1\n",
+		},
+		{
+			name:       "count",
+			expression: "object",
+			opts:       options{output: "table", where: []string{`object.status.phase == "Running"`}, count: true},
+			want:       "2\n",
+		},
+		{
+			name:       "count groups",
+			expression: "object",
+			opts:       options{output: "table", groupBy: []string{"object.metadata.namespace"}, count: true},
+			want:       "2\n",
+		},
+		{
+			name:       "json",
+			expression: `{"name": object.metadata.name, "labels": object.metadata.labels}`,
+			opts:       options{output: "json", where: []string{`object.metadata.name == "c"`}},
+			want:       "[\n  {\n    \"labels\": {\n      \"app\": \"c\"\n    },\n    \"name\": \"c\"\n  }\n]\n",
+		},
+		{
+			name:       "empty json",
+			expression: "object",
+			opts:       options{output: "json", where: []string{"false"}},
+			want:       "[]\n",
+		},
+		{
+			name:       "csv",
+			expression: `{"name": object.metadata.name, "restarts": object.status.?containerStatuses.orValue([]).map(c, c.restartCount)}`,
+			opts:       options{output: "csv"},
+			want:       "NAME,RESTARTS\na,\"[0,7]\"\nb,[]\nc,[9]\nd,[1]\n",
+		},
+		{
+			name:       "tsv",
+			expression: `{"name": object.metadata.name, "phase": object.status.phase}`,
+			opts:       options{output: "tsv", noHeaders: true},
+			want:       "a\tRunning\nb\tPending\nc\tRunning\nd\tFailed\n",
+		},
+		{
+			name:       "invalid expression",
+			expression: "object.",
+			opts:       options{output: "table"},
+			wantErr:    `invalid expression "object.": ERROR: <input>:1:8: Syntax error:`,
+		},
+		{
+			name:       "invalid filter",
+			expression: "object",
+			opts:       options{output: "table", where: []string{"object.status.phase"}},
+			wantErr:    `Pod ns1/a: --where "object.status.phase" returned Running, expected a bool`,
+		},
+		{
+			name:       "evaluation error",
+			expression: "object.status.containerStatuses",
+			opts:       options{output: "table"},
+			wantErr:    "Pod ns1/b: no such key: containerStatuses",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var out, errOut bytes.Buffer
+			err := query(&out, &errOut, objects, tc.expression, tc.opts)
+			if tc.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != tc.want {
+				t.Errorf("expected:\n%s\ngot:\n%s", tc.want, out.String())
+			}
+		})
+	}
+}
+
+func TestQuery_NoResources(t *testing.T) {
+	var out, errOut bytes.Buffer
+	if err := query(&out, &errOut, nil, "object", options{output: "table"}); err != nil {
+		t.Fatal(err)
+	}
+	if out.Len() != 0 || !strings.Contains(errOut.String(), "No resources found.") {
+		t.Errorf("expected no output and a notice, got %q and %q", out.String(), errOut.String())
+	}
+}
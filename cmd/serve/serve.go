@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package serve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/gmeghnag/omc/vars"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const contextName = "omc"
+
+var (
+	port           int
+	address        string
+	kubeconfigPath string
+)
+
+var ServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve the must-gather as a read-only Kubernetes API server.",
+	Long: `Serve the must-gather in use through the discovery, get, list and watch endpoints of the
+Kubernetes API, and write a kubeconfig pointing to it, so that oc, kubectl, k9s or any
+client-go based tool can query the must-gather. Every write request is rejected, and
+watches never report any change.`,
+	Example: `  omc serve --port 6443 &
+  export KUBECONFIG=~/.omc/kubeconfig
+  kubectl get pods -n openshift-etcd`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if vars.MustGatherRootPath == "" {
+			return fmt.Errorf("there are no must-gather resources defined, use \"omc use\" first")
+		}
+		if kubeconfigPath == "" {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				return err
+			}
+			kubeconfigPath = filepath.Join(home, ".omc", "kubeconfig")
+		}
+		listener, err := net.Listen("tcp", net.JoinHostPort(address, strconv.Itoa(port)))
+		if err != nil {
+			return err
+		}
+		serverURL := "http://" + listener.Addr().String()
+		if err := writeKubeconfig(kubeconfigPath, serverURL, vars.Namespace); err != nil {
+			listener.Close()
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "Serving must-gather %s on %s\n", vars.MustGatherRootPath, serverURL)
+		fmt.Fprintf(os.Stderr, "export KUBECONFIG=%s\n", kubeconfigPath)
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		server := &http.Server{Handler: newServer(vars.MustGatherRootPath), ReadHeaderTimeout: 10 * time.Second}
+		go func() {
+			<-ctx.Done()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			server.Shutdown(shutdownCtx)
+		}()
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	},
+}
+
+func init() {
+	ServeCmd.Flags().IntVar(&port, "port", 6443, "Port to listen on.")
+	ServeCmd.Flags().StringVar(&address, "address", "127.0.0.1", "Address to listen on.")
+	ServeCmd.Flags().StringVar(&kubeconfigPath, "kubeconfig", "", "Path of the kubeconfig file to write (default ~/.omc/kubeconfig).")
+}
+
+// writeKubeconfig writes a kubeconfig whose current context points to the server,
+// defaulting to the namespace in use.
+func writeKubeconfig(path string, server string, namespace string) error {
+	config := clientcmdapi.NewConfig()
+	config.Clusters[contextName] = &clientcmdapi.Cluster{Server: server}
+	config.AuthInfos[contextName] = &clientcmdapi.AuthInfo{}
+	config.Contexts[contextName] = &clientcmdapi.Context{Cluster: contextName, AuthInfo: contextName, Namespace: namespace}
+	config.CurrentContext = contextName
+	if err := clientcmd.WriteToFile(*config, path); err != nil {
+		return fmt.Errorf("error writing kubeconfig %s: %w", path, err)
+	}
+	return nil
+}
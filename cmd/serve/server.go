@@ -0,0 +1,396 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package serve
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gmeghnag/omc/cmd/apiresources"
+	"github.com/gmeghnag/omc/cmd/get"
+	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/cmd/logs"
+	"github.com/gmeghnag/omc/pkg/mustgather"
+	"github.com/gmeghnag/omc/vars"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// listResourceVersion is the resource version of every list, as the must-gather never changes.
+const listResourceVersion = "1"
+
+// server serves the discovery, get, list and watch endpoints of the Kubernetes API
+// from a must-gather; every other request is rejected.
+type server struct {
+	reader  *mustgather.Reader
+	catalog *apiresources.Catalog
+	// mu guards the package-level state shared with "omc get", used to resolve resource
+	// aliases and to print tables
+	mu sync.Mutex
+}
+
+func newServer(root string) *server {
+	reader := mustgather.NewReader(root, mustgather.WithParallelism(vars.Parallelism))
+	return &server{reader: reader, catalog: apiresources.NewCatalog(reader)}
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	klog.V(2).Info("INFO ", req.Method, " ", req.URL.String())
+	if req.Method != http.MethodGet {
+		writeError(w, apierrors.NewMethodNotSupported(schema.GroupResource{}, req.Method))
+		return
+	}
+	path := strings.TrimSuffix(req.URL.Path, "/")
+	switch {
+	case path == "/healthz" || path == "/livez" || path == "/readyz":
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, "ok")
+	case path == "/version":
+		writeJSON(w, http.StatusOK, s.version())
+	case path == "/api":
+		writeJSON(w, http.StatusOK, &metav1.APIVersions{
+			TypeMeta: metav1.TypeMeta{Kind: "APIVersions"},
+			Versions: []string{"v1"},
+			ServerAddressByClientCIDRs: []metav1.ServerAddressByClientCIDR{
+				{ClientCIDR: "0.0.0.0/0", ServerAddress: req.Host},
+			},
+		})
+	case path == "/apis":
+		writeJSON(w, http.StatusOK, &metav1.APIGroupList{
+			TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"},
+			Groups:   s.catalog.Groups,
+		})
+	case path == "/api/v1":
+		s.serveResourceList(w, schema.GroupVersion{Version: "v1"})
+	case strings.HasPrefix(path, "/apis/") && strings.Count(path, "/") == 2:
+		group, ok := s.catalog.Group(strings.TrimPrefix(path, "/apis/"))
+		if !ok {
+			writeError(w, apierrors.NewNotFound(schema.GroupResource{}, path))
+			return
+		}
+		group.TypeMeta = metav1.TypeMeta{Kind: "APIGroup", APIVersion: "v1"}
+		writeJSON(w, http.StatusOK, &group)
+	case strings.HasPrefix(path, "/apis/") && strings.Count(path, "/") == 3:
+		gv, err := schema.ParseGroupVersion(strings.TrimPrefix(path, "/apis/"))
+		if err != nil {
+			writeError(w, apierrors.NewNotFound(schema.GroupResource{}, path))
+			return
+		}
+		s.serveResourceList(w, gv)
+	default:
+		s.serveResource(w, req)
+	}
+}
+
+// version reports the version of the kube-apiserver of the must-gather, as found in the
+// status of the kube-apiserver cluster operator.
+func (s *server) version() *version.Info {
+	info := &version.Info{GitVersion: "v0.0.0-omc", Platform: "must-gather"}
+	co, err := s.reader.Get(schema.GroupVersionResource{Group: "config.openshift.io", Version: "v1", Resource: "clusteroperators"}, "", "kube-apiserver")
+	if err != nil {
+		return info
+	}
+	versions, _, _ := unstructured.NestedSlice(co.Object, "status", "versions")
+	for _, v := range versions {
+		operand, _ := v.(map[string]interface{})
+		if operand["name"] != "kube-apiserver" {
+			continue
+		}
+		kubeVersion, _ := operand["version"].(string)
+		parts := strings.SplitN(kubeVersion, ".", 3)
+		if len(parts) < 2 {
+			break
+		}
+		info.Major, info.Minor, info.GitVersion = parts[0], parts[1], "v"+kubeVersion
+	}
+	return info
+}
+
+func (s *server) serveResourceList(w http.ResponseWriter, gv schema.GroupVersion) {
+	resources, ok := s.catalog.Resources[gv]
+	if !ok {
+		writeError(w, apierrors.NewNotFound(schema.GroupResource{}, gv.String()))
+		return
+	}
+	writeJSON(w, http.StatusOK, &metav1.APIResourceList{
+		TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+		GroupVersion: gv.String(),
+		APIResources: resources,
+	})
+}
+
+// resolve returns the served resource of a request, falling back to the aliases known to
+// "omc get" for resources which are not advertised.
+func (s *server) resolve(gvr schema.GroupVersionResource) (metav1.APIResource, bool) {
+	if r, ok := s.catalog.ByGVR[gvr]; ok {
+		return r, true
+	}
+	alias := gvr.Resource
+	if gvr.Group != "" {
+		alias += "." + gvr.Group
+	}
+	s.mu.Lock()
+	plural, group, singular, namespaced, err := get.KindGroupNamespaced(alias)
+	s.mu.Unlock()
+	if group == "core" {
+		group = ""
+	}
+	if err != nil || plural != gvr.Resource || group != gvr.Group {
+		return metav1.APIResource{}, false
+	}
+	return metav1.APIResource{Name: plural, SingularName: singular, Namespaced: namespaced, Group: group, Version: gvr.Version}, true
+}
+
+func (s *server) serveResource(w http.ResponseWriter, req *http.Request) {
+	gvr, namespace, name, subresource, ok := helpers.ParseAPIPath(req.URL.Path)
+	if !ok {
+		writeError(w, apierrors.NewNotFound(schema.GroupResource{}, req.URL.Path))
+		return
+	}
+	resource, ok := s.resolve(gvr)
+	if !ok || (namespace != "" && !resource.Namespaced) {
+		writeError(w, apierrors.NewNotFound(gvr.GroupResource(), name))
+		return
+	}
+	query := req.URL.Query()
+	switch {
+	case subresource == "log" && gvr.Group == "" && gvr.Resource == "pods":
+		s.serveLogs(w, namespace, name, query.Get("container"), query.Get("previous") == "true", query.Get("tailLines"))
+	case subresource != "":
+		// subresources are not part of a must-gather
+		writeError(w, apierrors.NewNotFound(gvr.GroupResource(), name+"/"+subresource))
+	case name != "":
+		item, err := s.reader.Get(gvr, namespace, name)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if wantsTable(req) {
+			s.writeTable(w, []unstructured.Unstructured{*item})
+			return
+		}
+		writeJSON(w, http.StatusOK, item.Object)
+	default:
+		items, err := s.reader.List(gvr, namespace, metav1.ListOptions{
+			LabelSelector: query.Get("labelSelector"),
+			FieldSelector: query.Get("fieldSelector"),
+		})
+		if err != nil {
+			writeError(w, apierrors.NewBadRequest(err.Error()))
+			return
+		}
+		switch {
+		case query.Get("watch") == "true" || query.Get("watch") == "1":
+			s.serveWatch(w, req, items)
+		case wantsTable(req):
+			s.writeTable(w, items)
+		default:
+			writeList(w, resource, items)
+		}
+	}
+}
+
+// wantsTable reports whether the client, e.g. kubectl get, asks for a server-side printed table.
+func wantsTable(req *http.Request) bool {
+	for _, accept := range strings.Split(req.Header.Get("Accept"), ",") {
+		if strings.Contains(accept, "as=Table") {
+			return true
+		}
+	}
+	return false
+}
+
+func writeList(w http.ResponseWriter, resource metav1.APIResource, items []unstructured.Unstructured) {
+	kind := resource.Kind
+	if kind == "" && len(items) > 0 {
+		kind = items[0].GetKind()
+	}
+	objects := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		objects = append(objects, item.Object)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"apiVersion": schema.GroupVersion{Group: resource.Group, Version: resource.Version}.String(),
+		"kind":       kind + "List",
+		"metadata":   map[string]interface{}{"resourceVersion": listResourceVersion},
+		"items":      objects,
+	})
+}
+
+// writeTable writes the objects as the table printed by "omc get", with every column of the
+// wide output, so that clients can print either of them.
+func (s *server) writeTable(w http.ResponseWriter, items []unstructured.Unstructured) {
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{Kind: "Table", APIVersion: "meta.k8s.io/v1"},
+		ListMeta: metav1.ListMeta{ResourceVersion: listResourceVersion},
+		Rows:     []metav1.TableRow{},
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	wide, showNamespace, showKind, showLabels := vars.Wide, vars.ShowNamespace, vars.ShowKind, vars.ShowLabelsBoolVar
+	vars.Wide, vars.ShowNamespace, vars.ShowKind, vars.ShowLabelsBoolVar = true, false, false, false
+	defer func() {
+		vars.Wide, vars.ShowNamespace, vars.ShowKind, vars.ShowLabelsBoolVar = wide, showNamespace, showKind, showLabels
+	}()
+	for _, item := range items {
+		rawObject, err := yaml.Marshal(item.Object)
+		if err != nil {
+			writeError(w, apierrors.NewInternalError(err))
+			return
+		}
+		objectTable, err := get.ObjectTable(item, rawObject)
+		if err != nil {
+			writeError(w, apierrors.NewInternalError(err))
+			return
+		}
+		if table.ColumnDefinitions == nil {
+			table.ColumnDefinitions = objectTable.ColumnDefinitions
+		}
+		// clients read the namespace and labels of the rows from their object metadata
+		metadata, err := json.Marshal(map[string]interface{}{
+			"kind":       "PartialObjectMetadata",
+			"apiVersion": "meta.k8s.io/v1",
+			"metadata":   item.Object["metadata"],
+		})
+		if err != nil {
+			writeError(w, apierrors.NewInternalError(err))
+			return
+		}
+		for _, row := range objectTable.Rows {
+			row.Object.Raw = metadata
+			table.Rows = append(table.Rows, row)
+		}
+	}
+	writeJSON(w, http.StatusOK, table)
+}
+
+// serveWatch sends the listed objects as ADDED events, unless the client already listed them,
+// then keeps the connection open until the client goes away or its timeout expires, as the
+// must-gather never changes.
+func (s *server) serveWatch(w http.ResponseWriter, req *http.Request, items []unstructured.Unstructured) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+	resourceVersion := req.URL.Query().Get("resourceVersion")
+	if resourceVersion == "" || resourceVersion == "0" {
+		encoder := json.NewEncoder(w)
+		for _, item := range items {
+			raw, err := json.Marshal(item.Object)
+			if err != nil {
+				continue
+			}
+			if err := encoder.Encode(metav1.WatchEvent{Type: "ADDED", Object: runtime.RawExtension{Raw: raw}}); err != nil {
+				return
+			}
+		}
+	}
+	if flusher != nil {
+		flusher.Flush()
+	}
+	timeout := time.Duration(0)
+	if seconds, err := strconv.Atoi(req.URL.Query().Get("timeoutSeconds")); err == nil && seconds > 0 {
+		timeout = time.Duration(seconds) * time.Second
+	}
+	if timeout == 0 {
+		<-req.Context().Done()
+		return
+	}
+	select {
+	case <-req.Context().Done():
+	case <-time.After(timeout):
+	}
+}
+
+func (s *server) serveLogs(w http.ResponseWriter, namespace string, pod string, container string, previous bool, tailLines string) {
+	containerLogs, err := s.reader.PodLogs(namespace, pod, mustgather.PodLogOptions{Container: container, Previous: previous})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			err = apierrors.NewBadRequest(err.Error())
+		}
+		writeError(w, err)
+		return
+	}
+	c := containerLogs[0]
+	if !hasLogFile(c) {
+		kind := "container"
+		if previous {
+			kind = "previous terminated container"
+		}
+		writeError(w, &apierrors.StatusError{ErrStatus: metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusNotFound,
+			Reason:  metav1.StatusReasonNotFound,
+			Message: fmt.Sprintf("logs of %s %q in pod %q not found", kind, c.Container, pod),
+		}})
+		return
+	}
+	log := logs.NewContainerLogReader(c)
+	if tail, err := strconv.ParseInt(tailLines, 10, 64); err == nil && tail >= 0 {
+		log.WithTail(tail)
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	if err := log.Read(w); err != nil {
+		// the status is already sent: abort the response for the client not to take the
+		// truncated logs for complete ones
+		klog.V(1).ErrorS(err, "Unable to read logs", "namespace", namespace, "pod", pod)
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// hasLogFile returns whether one of the log files of a container was gathered.
+func hasLogFile(c mustgather.ContainerLog) bool {
+	for _, f := range c.Files {
+		if _, err := os.Stat(filepath.Join(c.Dir, f)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, code int, obj interface{}) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		code = http.StatusInternalServerError
+		data, _ = json.Marshal(apierrors.NewInternalError(err).Status())
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(data)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	status, ok := err.(apierrors.APIStatus)
+	if !ok {
+		status = apierrors.NewInternalError(err)
+	}
+	s := status.Status()
+	s.TypeMeta = metav1.TypeMeta{Kind: "Status", APIVersion: "v1"}
+	writeJSON(w, int(s.Code), &s)
+}
@@ -0,0 +1,336 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package serve
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gmeghnag/omc/internal/testutil"
+	"github.com/gmeghnag/omc/vars"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func newServeFixture(t *testing.T) *rest.Config {
+	root := testutil.MustGather(t, map[string]string{
+		"namespaces/ns1/ns1.yaml": testutil.Namespace("ns1"),
+		"namespaces/ns1/core/pods.yaml": `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: web-0
+    namespace: ns1
+    labels:
+      app: web
+  spec:
+    nodeName: master-0
+    containers:
+    - name: web
+      image: nginx
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: db-0
+    namespace: ns1
+    labels:
+      app: db
+  spec:
+    containers:
+    - name: db
+      image: postgres
+`,
+		"namespaces/ns1/pods/web-0/web/web/logs/current.log": "line 1\nline 2\nline 3\n",
+		"namespaces/ns1/example.com/widgets/gear.yaml": `apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: gear
+  namespace: ns1
+spec:
+  size: 3
+`,
+		"cluster-scoped-resources/apiextensions.k8s.io/customresourcedefinitions/widgets.example.com.yaml": `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+spec:
+  group: example.com
+  names:
+    kind: Widget
+    plural: widgets
+    singular: widget
+    shortNames:
+    - wd
+  scope: Namespaced
+  versions:
+  - name: v1
+    served: true
+    storage: true
+    additionalPrinterColumns:
+    - name: Size
+      type: integer
+      jsonPath: .spec.size
+`,
+		"cluster-scoped-resources/core/nodes/master-0.yaml": `apiVersion: v1
+kind: Node
+metadata:
+  name: master-0
+`,
+		"cluster-scoped-resources/config.openshift.io/clusteroperators/kube-apiserver.yaml": `apiVersion: config.openshift.io/v1
+kind: ClusterOperator
+metadata:
+  name: kube-apiserver
+status:
+  versions:
+  - name: raw-internal
+    version: 4.16.3
+  - name: kube-apiserver
+    version: 1.29.6
+`,
+	})
+	vars.MustGatherRootPath = root
+	t.Cleanup(func() { vars.MustGatherRootPath = "" })
+	ts := httptest.NewServer(newServer(root))
+	t.Cleanup(ts.Close)
+	return &rest.Config{Host: ts.URL}
+}
+
+func TestServeDiscovery(t *testing.T) {
+	config := newServeFixture(t)
+	client, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := client.ServerVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.GitVersion != "v1.29.6" || info.Minor != "29" {
+		t.Errorf("expected the kube-apiserver version of the must-gather, got %+v", info)
+	}
+	_, resourceLists, err := client.ServerGroupsAndResources()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resources := make(map[string]metav1.APIResource)
+	for _, list := range resourceLists {
+		for _, r := range list.APIResources {
+			resources[list.GroupVersion+"/"+r.Name] = r
+		}
+	}
+	tests := []struct {
+		resource   string
+		kind       string
+		namespaced bool
+	}{
+		{resource: "v1/pods", kind: "Pod", namespaced: true},
+		{resource: "v1/nodes", kind: "Node"},
+		{resource: "apps/v1/deployments", kind: "Deployment", namespaced: true},
+		{resource: "route.openshift.io/v1/routes", kind: "Route", namespaced: true},
+		{resource: "example.com/v1/widgets", kind: "Widget", namespaced: true},
+	}
+	for _, tc := range tests {
+		r, ok := resources[tc.resource]
+		if !ok {
+			t.Errorf("expected %s to be discovered", tc.resource)
+			continue
+		}
+		if r.Kind != tc.kind || r.Namespaced != tc.namespaced {
+			t.Errorf("expected %s to be a namespaced=%v %s, got %+v", tc.resource, tc.namespaced, tc.kind, r)
+		}
+	}
+	if r := resources["v1/pods"]; !strings.Contains(strings.Join(r.ShortNames, ","), "po") {
+		t.Errorf("expected the pods short names to include po, got %v", r.ShortNames)
+	}
+}
+
+func TestServeGetAndList(t *testing.T) {
+	config := newServeFixture(t)
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	pods, err := clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{LabelSelector: "app=web"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pods.Items) != 1 || pods.Items[0].Name != "web-0" {
+		t.Errorf("expected pod web-0, got %v", pods.Items)
+	}
+	pods, err = clientset.CoreV1().Pods("ns1").List(ctx, metav1.ListOptions{FieldSelector: "spec.nodeName=master-0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pods.Items) != 1 || pods.Items[0].Name != "web-0" {
+		t.Errorf("expected pod web-0, got %v", pods.Items)
+	}
+	node, err := clientset.CoreV1().Nodes().Get(ctx, "master-0", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if node.Name != "master-0" {
+		t.Errorf("expected node master-0, got %s", node.Name)
+	}
+	if _, err := clientset.CoreV1().Pods("ns1").Get(ctx, "web-1", metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a NotFound error, got %v", err)
+	}
+	if _, err := clientset.CoreV1().Pods("ns1").List(ctx, metav1.ListOptions{LabelSelector: "app in (web"}); !apierrors.IsBadRequest(err) {
+		t.Errorf("expected a BadRequest error, got %v", err)
+	}
+	if err := clientset.CoreV1().Pods("ns1").Delete(ctx, "web-0", metav1.DeleteOptions{}); !apierrors.IsMethodNotSupported(err) {
+		t.Errorf("expected a MethodNotSupported error, got %v", err)
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	widgets, err := dynamicClient.Resource(schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}).Namespace("ns1").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(widgets.Items) != 1 || widgets.Items[0].GetName() != "gear" {
+		t.Errorf("expected widget gear, got %v", widgets.Items)
+	}
+
+	tailLines := int64(2)
+	logs, err := clientset.CoreV1().Pods("ns1").GetLogs("web-0", &corev1.PodLogOptions{TailLines: &tailLines}).Do(ctx).Raw()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(logs) != "line 2\nline 3\n" {
+		t.Errorf("expected the last two log lines, got %q", logs)
+	}
+	_, err = clientset.CoreV1().Pods("ns1").GetLogs("web-0", &corev1.PodLogOptions{Previous: true}).Stream(ctx)
+	if !apierrors.IsNotFound(err) || !strings.Contains(err.Error(), `logs of previous terminated container "web" in pod "web-0" not found`) {
+		t.Errorf("expected a NotFound error for the missing previous logs, got %v", err)
+	}
+}
+
+func TestServeTable(t *testing.T) {
+	config := newServeFixture(t)
+	tests := []struct {
+		name        string
+		path        string
+		wantColumns string
+		wantRows    []string
+	}{
+		{name: "custom resources", path: "/apis/example.com/v1/namespaces/ns1/widgets", wantColumns: "Name,Size", wantRows: []string{"gear"}},
+		{name: "built-in resources", path: "/api/v1/namespaces/ns1/pods", wantColumns: "Name,Ready,Status,Restarts,Age,IP,Node,Nominated Node,Readiness Gates", wantRows: []string{"web-0", "db-0"}},
+		{name: "single object", path: "/api/v1/nodes/master-0", wantRows: []string{"master-0"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, config.Host+tc.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Accept", "application/json;as=Table;v=v1;g=meta.k8s.io,application/json")
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			var table metav1.Table
+			if err := json.NewDecoder(resp.Body).Decode(&table); err != nil {
+				t.Fatal(err)
+			}
+			var columns []string
+			for _, c := range table.ColumnDefinitions {
+				columns = append(columns, c.Name)
+			}
+			if got := strings.Join(columns, ","); tc.wantColumns != "" && got != tc.wantColumns {
+				t.Errorf("expected columns %s, got %s", tc.wantColumns, got)
+			}
+			var rows []string
+			for _, row := range table.Rows {
+				rows = append(rows, row.Cells[0].(string))
+				if len(row.Object.Raw) == 0 {
+					t.Errorf("expected the rows to hold the object metadata")
+				}
+			}
+			if strings.Join(rows, ",") != strings.Join(tc.wantRows, ",") {
+				t.Errorf("expected rows %v, got %v", tc.wantRows, rows)
+			}
+		})
+	}
+}
+
+func TestServeWatch(t *testing.T) {
+	config := newServeFixture(t)
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	w, err := clientset.CoreV1().Pods("ns1").Watch(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+	var added []string
+	for len(added) < 2 {
+		event, ok := <-w.ResultChan()
+		if !ok {
+			t.Fatalf("watch closed after %v", added)
+		}
+		if event.Type != watch.Added {
+			t.Fatalf("expected ADDED events, got %s", event.Type)
+		}
+		added = append(added, event.Object.(metav1.Object).GetName())
+	}
+	if strings.Join(added, ",") != "web-0,db-0" {
+		t.Errorf("expected the pods of ns1, got %v", added)
+	}
+
+	// watches from a listed resource version never report any event
+	req, err := http.NewRequest(http.MethodGet, config.Host+"/api/v1/namespaces/ns1/pods?watch=true&resourceVersion=1&timeoutSeconds=1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	if len(body) != 0 {
+		t.Errorf("expected no events, got %s", body)
+	}
+}
+
+func TestWriteKubeconfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kubeconfig")
+	if err := writeKubeconfig(path, "http://127.0.0.1:6443", "ns1"); err != nil {
+		t.Fatal(err)
+	}
+	config, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	current := config.Contexts[config.CurrentContext]
+	if current == nil || current.Namespace != "ns1" || config.Clusters[current.Cluster].Server != "http://127.0.0.1:6443" {
+		t.Errorf("unexpected kubeconfig %+v", config)
+	}
+}
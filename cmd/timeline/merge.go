@@ -0,0 +1,177 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package timeline
+
+import (
+	"bytes"
+	"container/heap"
+	"fmt"
+	"io"
+	"iter"
+	"time"
+
+	"github.com/gmeghnag/omc/cmd/logs"
+)
+
+// entry is a line of a source of the timeline, at the time it was logged.
+type entry struct {
+	time time.Time
+	text []byte
+	// continued is set for the lines without a timestamp, such as the continuation of a
+	// multi-line message, which keep the time of the line before them.
+	continued bool
+}
+
+// source is a named sequence of entries, in chronological order.
+type source struct {
+	name    string
+	entries iter.Seq2[entry, error]
+}
+
+// logEntries returns the entries of the lines of a log in the window, timestamped by parse,
+// the text of each line being returned by message. The lines are read as they are merged, the
+// reading stopping at the end of the window.
+func logEntries(lines iter.Seq2[[]byte, error], parse logs.TimestampParser, message func([]byte) []byte, window logs.TimeWindow) iter.Seq2[entry, error] {
+	return func(yield func(entry, error) bool) {
+		var last time.Time
+		inWindow := false
+		for line, err := range lines {
+			if err != nil {
+				yield(entry{}, err)
+				return
+			}
+			t, ok := parse(line)
+			if !ok {
+				// the lines before the first timestamp have no time to be merged at
+				if inWindow && !yield(entry{time: last, text: line, continued: true}, nil) {
+					return
+				}
+				continue
+			}
+			if !window.Until.IsZero() && t.After(window.Until) {
+				return
+			}
+			last = t
+			inWindow = window.Contains(t)
+			if inWindow && !yield(entry{time: t, text: message(line)}, nil) {
+				return
+			}
+		}
+	}
+}
+
+// merge writes the entries of the sources ordered by time, each line prefixed by its time and
+// tagged by the name of its source. It is a k-way merge reading the next entry of a source once
+// its current one is written, so that a single line of each source is held in memory.
+func merge(w io.Writer, sources []source) error {
+	h := &heads{}
+	defer func() {
+		for _, head := range *h {
+			head.stop()
+		}
+	}()
+	for i, s := range sources {
+		next, stop := iter.Pull2(s.entries)
+		head := &head{source: s.name, index: i, next: next, stop: stop}
+		ok, err := head.advance()
+		if err != nil {
+			stop()
+			return fmt.Errorf("read %s: %w", s.name, err)
+		}
+		if ok {
+			heap.Push(h, head)
+		} else {
+			stop()
+		}
+	}
+	var line bytes.Buffer
+	for h.Len() > 0 {
+		head := (*h)[0]
+		// a line is written with the lines continuing it, which share its time
+		for {
+			line.Reset()
+			line.WriteString(head.entry.time.UTC().Format(logs.RFC3339NanoFixed))
+			line.WriteString(" [")
+			line.WriteString(head.source)
+			line.WriteString("] ")
+			line.Write(head.entry.text)
+			line.WriteByte('\n')
+			if _, err := w.Write(line.Bytes()); err != nil {
+				return fmt.Errorf("write timeline: %w", err)
+			}
+			ok, err := head.advance()
+			if err != nil {
+				return fmt.Errorf("read %s: %w", head.source, err)
+			}
+			if !ok {
+				head.stop()
+				heap.Pop(h)
+				break
+			}
+			if !head.entry.continued {
+				heap.Fix(h, 0)
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// head is the current entry of a source being merged.
+type head struct {
+	source string
+	// index orders the sources of entries logged at the same time
+	index int
+	entry entry
+	next  func() (entry, error, bool)
+	stop  func()
+}
+
+func (h *head) advance() (bool, error) {
+	e, err, ok := h.next()
+	if !ok {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	h.entry = e
+	return true, nil
+}
+
+// heads is a min-heap of the heads of the sources, by time.
+type heads []*head
+
+func (h heads) Len() int { return len(h) }
+
+func (h heads) Less(i, j int) bool {
+	if c := h[i].entry.time.Compare(h[j].entry.time); c != 0 {
+		return c < 0
+	}
+	return h[i].index < h[j].index
+}
+
+func (h heads) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *heads) Push(x any) { *h = append(*h, x.(*head)) }
+
+func (h *heads) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package timeline
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"iter"
+	"testing"
+	"time"
+
+	"github.com/gmeghnag/omc/cmd/logs"
+	"github.com/gmeghnag/omc/internal/testutil"
+	"github.com/gmeghnag/omc/pkg/mustgather"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func at(second int) time.Time {
+	return time.Date(2026, 10, 1, 10, 0, second, 0, time.UTC)
+}
+
+func entries(list ...entry) iter.Seq2[entry, error] {
+	return func(yield func(entry, error) bool) {
+		for _, e := range list {
+			if !yield(e, nil) {
+				return
+			}
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	sources := []source{
+		{name: "a", entries: entries(entry{time: at(1), text: []byte("a1")}, entry{time: at(3), text: []byte("a3")}, entry{time: at(3), text: []byte("  a3 continued"), continued: true})},
+		{name: "b", entries: entries(entry{time: at(2), text: []byte("b2")}, entry{time: at(3), text: []byte("b3")}, entry{time: at(5), text: []byte("b5")})},
+		{name: "empty", entries: entries()},
+	}
+	var out bytes.Buffer
+	if err := merge(&out, sources); err != nil {
+		t.Fatal(err)
+	}
+	want := "2026-10-01T10:00:01.000000000Z [a] a1\n" +
+		"2026-10-01T10:00:02.000000000Z [b] b2\n" +
+		"2026-10-01T10:00:03.000000000Z [a] a3\n" +
+		"2026-10-01T10:00:03.000000000Z [a]   a3 continued\n" +
+		"2026-10-01T10:00:03.000000000Z [b] b3\n" +
+		"2026-10-01T10:00:05.000000000Z [b] b5\n"
+	if out.String() != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, out.String())
+	}
+}
+
+func TestMerge_Error(t *testing.T) {
+	failing := func(yield func(entry, error) bool) {
+		if yield(entry{time: at(1), text: []byte("ok")}, nil) {
+			yield(entry{}, errors.New("corrupted"))
+		}
+	}
+	var out bytes.Buffer
+	err := merge(&out, []source{{name: "a", entries: failing}})
+	if err == nil || err.Error() != "read a: corrupted" {
+		t.Fatalf("expected a read error, got %v", err)
+	}
+}
+
+func TestLogEntries(t *testing.T) {
+	var rotated bytes.Buffer
+	gz := gzip.NewWriter(&rotated)
+	gz.Write([]byte("2026-10-01T10:00:01Z stdout F rotated before\n2026-10-01T10:00:02Z stdout F rotated\n"))
+	gz.Close()
+	dir := t.TempDir()
+	testutil.WriteFiles(t, dir, map[string]string{
+		"rotated/0.log.20261001-100002.gz": rotated.String(),
+		// the last line of the rotated log is also in previous.log
+		"previous.log": "2026-10-01T10:00:02Z stdout F rotated\n2026-10-01T10:00:03Z stdout F previous\n",
+		"current.log":  "2026-10-01T10:00:04Z current\n  continued\n2026-10-01T10:00:09Z after\n",
+	})
+	c := mustgather.ContainerLog{Pod: "p", Container: "c", Dir: dir, Files: mustgather.LogFiles(dir, mustgather.PodLogOptions{AllLogs: true})}
+	window := logs.TimeWindow{Since: at(2), Until: at(5)}
+	var out bytes.Buffer
+	if err := merge(&out, []source{{name: "pod/ns/p/c", entries: logEntries(logs.NewContainerLogReader(c).StitchedLines(), logs.CRITimestamp, logs.CRIMessage, window)}}); err != nil {
+		t.Fatal(err)
+	}
+	want := "2026-10-01T10:00:02.000000000Z [pod/ns/p/c] rotated\n" +
+		"2026-10-01T10:00:03.000000000Z [pod/ns/p/c] previous\n" +
+		"2026-10-01T10:00:04.000000000Z [pod/ns/p/c] current\n" +
+		"2026-10-01T10:00:04.000000000Z [pod/ns/p/c]   continued\n"
+	if out.String() != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, out.String())
+	}
+}
+
+func TestEventEntries(t *testing.T) {
+	event := func(name string, second int) corev1.Event {
+		return corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "ns"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "p"},
+			Type:           "Warning",
+			Reason:         name,
+			Message:        "message\n",
+			LastTimestamp:  metav1.NewTime(at(second)),
+		}
+	}
+	items := []corev1.Event{event("Before", 1), event("BackOff", 2), event("After", 9)}
+	var out bytes.Buffer
+	if err := merge(&out, []source{{name: "event/ns", entries: eventEntries(items, logs.TimeWindow{Since: at(2), Until: at(5)})}}); err != nil {
+		t.Fatal(err)
+	}
+	if want := "2026-10-01T10:00:02.000000000Z [event/ns] pod/p Warning BackOff: message\n"; out.String() != want {
+		t.Errorf("expected %q, got %q", want, out.String())
+	}
+}
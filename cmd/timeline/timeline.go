@@ -0,0 +1,232 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package timeline
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"iter"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gmeghnag/omc/cmd/events"
+	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/cmd/logs"
+	"github.com/gmeghnag/omc/pkg/mustgather"
+	"github.com/gmeghnag/omc/vars"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	includeEvents, includeNodeLogs bool
+	since                          time.Duration
+	sinceTime, untilTime           string
+)
+
+var Timeline = &cobra.Command{
+	Use:   "timeline",
+	Short: "Print the logs of the containers of a namespace, node services and events ordered by time.",
+	Long: `Print the logs of the containers of the pods of one or more namespaces, merged and ordered by time,
+each line prefixed by its time and tagged by its source:
+
+  pod/<namespace>/<pod>/<container>   the rotated, previous and current logs of a container
+  node/<role>/<service>               the logs of a node service, with --include-node-logs
+  event/<namespace>                   the events of the namespaces, with --include-events
+
+The logs are merged as they are read, so that the rotated logs, even gzipped, are not loaded in memory.`,
+	Example: `  # Print what happened in etcd and the kube-apiserver during an incident
+  omc timeline -n openshift-etcd,openshift-kube-apiserver --since-time 2026-10-01T10:00:00Z --until-time 2026-10-01T10:15:00Z --include-events --include-node-logs
+
+  # Print the last 10 minutes before the must-gather was collected, of the pods labeled app=etcd
+  omc timeline -n openshift-etcd -l app=etcd --since 10m`,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if vars.MustGatherRootPath == "" {
+			return fmt.Errorf("there are no must-gather resources defined")
+		}
+		if len(args) > 0 {
+			return fmt.Errorf("unexpected arguments %v: the namespaces are selected with -n or -A", args)
+		}
+		now := helpers.MustGatherTime(vars.MustGatherRootPath)
+		window, err := logs.NewTimeWindow(since, sinceTime, untilTime, now)
+		if err != nil {
+			return err
+		}
+		namespaces := []string{""}
+		if !vars.AllNamespaceBoolVar {
+			namespaces = strings.Split(vars.Namespace, ",")
+		}
+		reader := mustgather.NewReader(vars.MustGatherRootPath)
+		sources, err := containerSources(reader, namespaces, vars.LabelSelectorStringVar, window)
+		if err != nil {
+			return err
+		}
+		if includeNodeLogs {
+			nodeSources, err := nodeLogSources(vars.MustGatherRootPath, window, now)
+			if err != nil {
+				return err
+			}
+			sources = append(sources, nodeSources...)
+		}
+		if includeEvents {
+			eventSources, err := eventSources(reader, namespaces, window)
+			if err != nil {
+				return err
+			}
+			sources = append(sources, eventSources...)
+		}
+		if len(sources) == 0 {
+			fmt.Fprintln(os.Stderr, "No logs found.")
+			return nil
+		}
+		out := bufio.NewWriter(os.Stdout)
+		if err := merge(out, sources); err != nil {
+			return err
+		}
+		return out.Flush()
+	},
+}
+
+func init() {
+	Timeline.Flags().BoolVarP(&vars.AllNamespaceBoolVar, "all-namespaces", "A", false, "If present, merge the logs of the pods of all namespaces.")
+	Timeline.Flags().StringVarP(&vars.LabelSelectorStringVar, "selector", "l", "", "Selector (label query) of the pods to merge the logs of, supports '=', '==', '!=', 'in', 'notin', 'key' and '!key'.(e.g. -l key1=value1,key2 in (value2,value3),!key3)")
+	Timeline.Flags().BoolVar(&includeEvents, "include-events", false, "If present, merge the events of the namespaces.")
+	Timeline.Flags().BoolVar(&includeNodeLogs, "include-node-logs", false, "If present, merge the logs of the node services (host_service_logs).")
+	Timeline.Flags().DurationVar(&since, "since", 0, "Only return logs newer than a relative duration like 5s, 2m, or 3h, before the must-gather was collected. Only one of since-time / since may be used.")
+	Timeline.Flags().StringVar(&sinceTime, "since-time", "", "Only return logs after a specific date (RFC3339). Only one of since-time / since may be used.")
+	Timeline.Flags().StringVar(&untilTime, "until-time", "", "Only return logs before a specific date (RFC3339).")
+}
+
+// containerSources returns the rotated, previous and current logs of the containers of the pods of the
+// namespaces matching a label selector.
+func containerSources(reader *mustgather.Reader, namespaces []string, selector string, window logs.TimeWindow) ([]source, error) {
+	var sources []source
+	for _, namespace := range namespaces {
+		pods, err := reader.List(schema.GroupVersionResource{Version: "v1", Resource: "pods"}, namespace, metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return nil, err
+		}
+		for _, pod := range pods {
+			containerLogs, err := reader.PodLogs(pod.GetNamespace(), pod.GetName(), mustgather.PodLogOptions{AllContainers: true})
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range containerLogs {
+				// the files are stitched by time, the lines of previous.log also in a rotated log
+				// being read once
+				c.Files = mustgather.LogFiles(c.Dir, mustgather.PodLogOptions{AllLogs: true})
+				// the files of all the containers are read at once, without being held open
+				logReader := logs.NewContainerLogReader(c)
+				logReader.WithDetachedFiles()
+				sources = append(sources, source{
+					name:    fmt.Sprintf("pod/%s/%s/%s", pod.GetNamespace(), c.Pod, c.Container),
+					entries: logEntries(logReader.StitchedLines(), logs.CRITimestamp, logs.CRIMessage, window),
+				})
+			}
+		}
+	}
+	return sources, nil
+}
+
+// nodeLogSources returns the logs of the node services, gathered as
+// host_service_logs/<role>/<service>_service.log.
+func nodeLogSources(root string, window logs.TimeWindow, now time.Time) ([]source, error) {
+	files, err := filepath.Glob(filepath.Join(root, "host_service_logs", "*", "*_service.log"))
+	if err != nil {
+		return nil, err
+	}
+	var sources []source
+	for _, file := range files {
+		role := filepath.Base(filepath.Dir(file))
+		service := strings.TrimSuffix(filepath.Base(file), "_service.log")
+		logReader := logs.NewFileLogReader(file)
+		logReader.WithDetachedFiles()
+		sources = append(sources, source{
+			name:    fmt.Sprintf("node/%s/%s", role, service),
+			entries: logEntries(logReader.Lines(), logs.JournalTimestamp(now), journalMessage, window),
+		})
+	}
+	return sources, nil
+}
+
+// eventSources returns the events of the namespaces in the window, a source per namespace.
+func eventSources(reader *mustgather.Reader, namespaces []string, window logs.TimeWindow) ([]source, error) {
+	var sources []source
+	for _, namespace := range namespaces {
+		eventList, err := reader.Events(namespace)
+		if err != nil {
+			return nil, err
+		}
+		events.SortEventList(eventList)
+		// the events of all namespaces are read at once
+		byNamespace := map[string][]corev1.Event{}
+		var names []string
+		for _, e := range eventList.Items {
+			if _, ok := byNamespace[e.Namespace]; !ok {
+				names = append(names, e.Namespace)
+			}
+			byNamespace[e.Namespace] = append(byNamespace[e.Namespace], e)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			sources = append(sources, source{name: "event/" + name, entries: eventEntries(byNamespace[name], window)})
+		}
+	}
+	return sources, nil
+}
+
+// eventEntries returns the events, sorted by time, in the window, as
+// <kind>/<name> <type> <reason>: <message>.
+func eventEntries(items []corev1.Event, window logs.TimeWindow) iter.Seq2[entry, error] {
+	return func(yield func(entry, error) bool) {
+		for _, e := range items {
+			t := events.GetLastTime(e).Time
+			if !window.Until.IsZero() && t.After(window.Until) {
+				return
+			}
+			if !window.Contains(t) {
+				continue
+			}
+			involved := e.InvolvedObject
+			text := fmt.Sprintf("%s/%s %s %s: %s", strings.ToLower(involved.Kind), involved.Name, e.Type, e.Reason, strings.TrimSpace(e.Message))
+			if !yield(entry{time: t, text: []byte(text)}, nil) {
+				return
+			}
+		}
+	}
+}
+
+// journalMessage returns a line of a journal log without its timestamp:
+// Nov 02 06:12:08.604741 master-0 kubenswrapper[2345]: message.
+func journalMessage(line []byte) []byte {
+	for i := 0; i < 3; i++ {
+		line = bytes.TrimLeft(line, " ")
+		idx := bytes.IndexByte(line, ' ')
+		if idx < 0 {
+			return line
+		}
+		line = line[idx+1:]
+	}
+	return line
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+//go:build unix
+
+package timeline
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/gmeghnag/omc/cmd/logs"
+	"github.com/gmeghnag/omc/internal/testutil"
+	"github.com/gmeghnag/omc/pkg/mustgather"
+)
+
+func TestMerge_ManyContainers(t *testing.T) {
+	// the log files of the containers are more than the files the process can open
+	const pods = 100
+	files := map[string]string{"namespaces/ns/ns.yaml": testutil.Namespace("ns")}
+	for i := range pods {
+		name := fmt.Sprintf("p%d", i)
+		files["namespaces/ns/pods/"+name+"/"+name+".yaml"] = fmt.Sprintf("apiVersion: v1\nkind: Pod\nmetadata:\n  name: %s\n  namespace: ns\nspec:\n  containers:\n  - name: c\n", name)
+		var rotated bytes.Buffer
+		gz := gzip.NewWriter(&rotated)
+		fmt.Fprintf(gz, "2026-10-01T10:00:01Z stdout F %s rotated\n", name)
+		gz.Close()
+		dir := "namespaces/ns/pods/" + name + "/c/c/logs/"
+		files[dir+"rotated/0.log.20261001-100001.gz"] = rotated.String()
+		files[dir+"previous.log"] = fmt.Sprintf("2026-10-01T10:00:02Z stdout F %s previous\n", name)
+		files[dir+"current.log"] = fmt.Sprintf("2026-10-01T10:00:03Z stdout F %s current\n", name)
+	}
+	root := testutil.MustGather(t, files)
+
+	var limit syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &limit); err != nil {
+		t.Fatal(err)
+	}
+	lowered := limit
+	lowered.Cur = 64
+	if err := syscall.Setrlimit(syscall.RLIMIT_NOFILE, &lowered); err != nil {
+		t.Skipf("unable to lower the limit of open files: %v", err)
+	}
+	t.Cleanup(func() { syscall.Setrlimit(syscall.RLIMIT_NOFILE, &limit) })
+
+	sources, err := containerSources(mustgather.NewReader(root), []string{"ns"}, "", logs.TimeWindow{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := merge(&out, sources); err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(out.String(), "\n"); lines != 3*pods {
+		t.Errorf("expected %d lines, got %d", 3*pods, lines)
+	}
+	if want := "2026-10-01T10:00:01.000000000Z [pod/ns/p0/c] p0 rotated\n"; !strings.HasPrefix(out.String(), want) {
+		t.Errorf("expected the timeline to start with %q, got %q", want, out.String()[:len(want)])
+	}
+}
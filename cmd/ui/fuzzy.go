@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ui
+
+import (
+	"strings"
+	"unicode"
+)
+
+// fuzzyScore reports whether the runes of pattern appear in s in the same order, ignoring case.
+// The score of a match favours consecutive runes and runes starting a word of s, e.g. "kas"
+// scores higher for "kube-apiserver" than for "kube-scheduler-pass".
+func fuzzyScore(pattern string, s string) (int, bool) {
+	p := []rune(strings.ToLower(pattern))
+	runes := []rune(strings.ToLower(s))
+	score, matched, last := 0, 0, -2
+	for i, r := range runes {
+		if matched == len(p) {
+			break
+		}
+		if r != p[matched] {
+			continue
+		}
+		score++
+		if i == last+1 {
+			score += 2
+		}
+		if i == 0 || !unicode.IsLetter(runes[i-1]) && !unicode.IsDigit(runes[i-1]) {
+			score += 3
+		}
+		last = i
+		matched++
+	}
+	return score, matched == len(p)
+}
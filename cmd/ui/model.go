@@ -0,0 +1,379 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gmeghnag/omc/pkg/mustgather"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// listKind tells what the rows of a list view hold, and so what can be opened from them.
+type listKind int
+
+const (
+	namespaceList listKind = iota
+	resourceList
+	objectList
+	logList
+)
+
+// row is an entry of a list view.
+type row struct {
+	cells []string
+	// key is the text matched by the fuzzy search
+	key string
+	// namespace is the namespace of the row, empty for cluster-scoped ones
+	namespace string
+	resource  schema.GroupResource
+	object    *unstructured.Unstructured
+	log       mustgather.ContainerLog
+}
+
+// view is a screen of the UI, either a list of rows or a text. Views are stacked as the user
+// drills down, and popped when going back.
+type view struct {
+	title string
+	text  bool
+	// lines are the lines of a text view
+	lines []string
+	// offset is the first line or row displayed
+	offset int
+
+	kind   listKind
+	header []string
+	widths []int
+	rows   []row
+	// visible are the indexes of the rows matching the search query, in display order
+	visible []int
+	query   string
+	cursor  int
+}
+
+func newListView(title string, kind listKind, header []string, rows []row) *view {
+	v := &view{title: title, kind: kind, header: header, rows: rows}
+	v.widths = make([]int, len(header))
+	for i, h := range header {
+		v.widths[i] = len(h)
+	}
+	for i := range rows {
+		if rows[i].key == "" && len(rows[i].cells) > 0 {
+			rows[i].key = rows[i].cells[0]
+		}
+		for j, c := range rows[i].cells {
+			if j < len(v.widths) && len(c) > v.widths[j] {
+				v.widths[j] = len(c)
+			}
+		}
+	}
+	v.setQuery("")
+	return v
+}
+
+func newTextView(title string, text string) *view {
+	text = strings.ReplaceAll(strings.TrimRight(text, "\n"), "\t", "    ")
+	return &view{title: title, text: true, lines: strings.Split(text, "\n")}
+}
+
+// setQuery filters the rows with a fuzzy search, listing the best matches first.
+func (v *view) setQuery(query string) {
+	v.query = query
+	type match struct {
+		index int
+		score int
+	}
+	var matches []match
+	for i, r := range v.rows {
+		if score, ok := fuzzyScore(query, r.key); ok {
+			matches = append(matches, match{index: i, score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].score > matches[j].score })
+	v.visible = make([]int, len(matches))
+	for i, match := range matches {
+		v.visible[i] = match.index
+	}
+	v.cursor, v.offset = 0, 0
+}
+
+// selected returns the row under the cursor.
+func (v *view) selected() (row, bool) {
+	if v.text || v.cursor >= len(v.visible) {
+		return row{}, false
+	}
+	return v.rows[v.visible[v.cursor]], true
+}
+
+// scroll moves the cursor of a list, or the first line of a text, by delta within a page of the given size.
+func (v *view) scroll(delta int, page int) {
+	if v.text {
+		v.offset = clamp(clamp(v.offset, 0, len(v.lines)-page)+delta, 0, len(v.lines)-page)
+		return
+	}
+	v.cursor = clamp(v.cursor+delta, 0, len(v.visible)-1)
+	if v.cursor < v.offset {
+		v.offset = v.cursor
+	} else if v.cursor >= v.offset+page {
+		v.offset = v.cursor - page + 1
+	}
+}
+
+func clamp(n int, low int, high int) int {
+	if n > high {
+		n = high
+	}
+	if n < low {
+		n = low
+	}
+	return n
+}
+
+var (
+	titleStyle    = lipgloss.NewStyle().Bold(true)
+	headerStyle   = lipgloss.NewStyle().Bold(true).Underline(true)
+	selectedStyle = lipgloss.NewStyle().Reverse(true)
+	errorStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	helpStyle     = lipgloss.NewStyle().Faint(true)
+)
+
+type model struct {
+	reader *mustgather.Reader
+	stack  []*view
+	// searching is set while the search query of the current view is typed
+	searching bool
+	// status is the error of the last action, if any
+	status string
+	width  int
+	height int
+}
+
+// newModel returns a model listing the namespaces of the must-gather, with the given one selected.
+func newModel(reader *mustgather.Reader, namespace string) (model, error) {
+	v, err := namespacesView(reader)
+	if err != nil {
+		return model{}, err
+	}
+	for i, r := range v.rows {
+		if namespace != "" && r.namespace == namespace {
+			v.cursor = i
+		}
+	}
+	return model{reader: reader, stack: []*view{v}, width: 80, height: 24}, nil
+}
+
+func (m model) current() *view {
+	return m.stack[len(m.stack)-1]
+}
+
+// pageSize is the number of rows or lines displayed, besides the title, header, status and help lines.
+func (m model) pageSize() int {
+	return max(m.height-4, 1)
+}
+
+func (m model) Init() tea.Cmd {
+	return nil
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.current().scroll(0, m.pageSize())
+	case tea.KeyMsg:
+		if m.searching {
+			return m.search(msg)
+		}
+		m.status = ""
+		v := m.current()
+		switch key := msg.String(); key {
+		case "q", "ctrl+c":
+			return m, tea.Quit
+		case "esc", "backspace":
+			if v.query != "" {
+				v.setQuery("")
+			} else if len(m.stack) > 1 {
+				m.stack = m.stack[:len(m.stack)-1]
+			}
+		case "up", "k":
+			v.scroll(-1, m.pageSize())
+		case "down", "j":
+			v.scroll(1, m.pageSize())
+		case "pgup", "ctrl+b":
+			v.scroll(-m.pageSize(), m.pageSize())
+		case "pgdown", "ctrl+f", " ":
+			v.scroll(m.pageSize(), m.pageSize())
+		case "home", "g":
+			v.scroll(-len(v.lines)-len(v.rows), m.pageSize())
+		case "end", "G":
+			v.scroll(len(v.lines)+len(v.rows), m.pageSize())
+		case "/":
+			m.searching = !v.text
+		case "enter", "y", "d", "e", "l", "r":
+			m.open(key)
+		}
+	}
+	return m, nil
+}
+
+// search edits the search query of the current view.
+func (m model) search(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	v := m.current()
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	case tea.KeyEnter:
+		m.searching = false
+	case tea.KeyEsc:
+		m.searching = false
+		v.setQuery("")
+	case tea.KeyBackspace:
+		if query := []rune(v.query); len(query) > 0 {
+			v.setQuery(string(query[:len(query)-1]))
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		v.setQuery(v.query + string(msg.Runes))
+	}
+	return m, nil
+}
+
+// open pushes the view opened by key from the selected row of the current view.
+func (m *model) open(key string) {
+	r, ok := m.current().selected()
+	if !ok {
+		return
+	}
+	var next *view
+	var err error
+	switch m.current().kind {
+	case namespaceList:
+		if key == "enter" {
+			next, err = resourcesView(m.reader, r.namespace)
+		}
+	case resourceList:
+		if key == "enter" {
+			next, err = objectsView(m.reader, r.resource, r.namespace)
+		}
+	case objectList:
+		switch key {
+		case "enter", "y":
+			next, err = yamlView(r.object)
+		case "d":
+			next, err = describeView(m.reader, r.resource, r.object)
+		case "e":
+			next, err = eventsView(m.reader, r.object)
+		case "l":
+			next, err = logsView(m.reader, r.object)
+		case "r":
+			next, err = relatedView(m.reader, r.object)
+		}
+	case logList:
+		if key == "enter" {
+			next, err = logView(r.log)
+		}
+	}
+	if err != nil {
+		m.status = err.Error()
+		return
+	}
+	if next != nil {
+		m.stack = append(m.stack, next)
+	}
+}
+
+func (m model) View() string {
+	v := m.current()
+	page := m.pageSize()
+	var lines []string
+	if v.text {
+		offset := clamp(v.offset, 0, len(v.lines)-page)
+		for _, l := range v.lines[offset:min(offset+page, len(v.lines))] {
+			lines = append(lines, truncate(l, m.width))
+		}
+	} else {
+		lines = append(lines, headerStyle.Render(truncate(formatCells(v.header, v.widths), m.width)))
+		for i := v.offset; i < min(v.offset+page, len(v.visible)); i++ {
+			line := truncate(formatCells(v.rows[v.visible[i]].cells, v.widths), m.width)
+			if i == v.cursor {
+				line = selectedStyle.Render(line)
+			}
+			lines = append(lines, line)
+		}
+	}
+	var status string
+	switch {
+	case m.searching:
+		status = "/" + v.query + "█"
+	case m.status != "":
+		status = errorStyle.Render(truncate(m.status, m.width))
+	case v.query != "":
+		status = fmt.Sprintf("/%s (%d/%d)", v.query, len(v.visible), len(v.rows))
+	}
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(truncate(v.title, m.width)) + "\n")
+	for _, l := range lines {
+		b.WriteString(l + "\n")
+	}
+	// keep the status and help lines at the bottom of the screen
+	for i := len(lines); i < page+1; i++ {
+		b.WriteString("\n")
+	}
+	b.WriteString(status + "\n")
+	b.WriteString(helpStyle.Render(truncate(help(v), m.width)))
+	return b.String()
+}
+
+// help returns the keys available in a view.
+func help(v *view) string {
+	switch {
+	case v.text:
+		return "↑/↓ pgup/pgdn g/G: scroll  esc: back  q: quit"
+	case v.kind == objectList:
+		return "enter/y: yaml  d: describe  e: events  l: logs  r: related  /: search  esc: back  q: quit"
+	default:
+		return "enter: open  /: search  esc: back  q: quit"
+	}
+}
+
+// formatCells pads the cells to the widths of their columns.
+func formatCells(cells []string, widths []int) string {
+	var b strings.Builder
+	for i, c := range cells {
+		if i > 0 {
+			b.WriteString("   ")
+		}
+		if i < len(cells)-1 && i < len(widths) {
+			c = fmt.Sprintf("%-*s", widths[i], c)
+		}
+		b.WriteString(c)
+	}
+	return b.String()
+}
+
+// truncate cuts s to width runes.
+func truncate(s string, width int) string {
+	runes := []rune(s)
+	if width <= 0 || len(runes) <= width {
+		return s
+	}
+	return string(runes[:width-1]) + "…"
+}
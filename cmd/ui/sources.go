@@ -0,0 +1,315 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/gmeghnag/omc/cmd/describe"
+	"github.com/gmeghnag/omc/cmd/events"
+	"github.com/gmeghnag/omc/cmd/get"
+	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/cmd/logs"
+	"github.com/gmeghnag/omc/pkg/mustgather"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// clusterScopedEntry is the entry of the namespaces list opening the cluster-scoped resources.
+const clusterScopedEntry = "(cluster-scoped)"
+
+var (
+	podsResource  = schema.GroupResource{Resource: "pods"}
+	nodesResource = schema.GroupResource{Resource: "nodes"}
+)
+
+// namespacesView lists the namespaces of the must-gather, after an entry for the cluster-scoped resources.
+func namespacesView(reader *mustgather.Reader) (*view, error) {
+	namespaces, err := reader.Namespaces()
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	rows := []row{{cells: []string{clusterScopedEntry}}}
+	for _, ns := range namespaces {
+		rows = append(rows, row{cells: []string{ns}, namespace: ns})
+	}
+	return newListView("namespaces", namespaceList, []string{"NAMESPACE"}, rows), nil
+}
+
+// resourcesView lists the resources stored in a namespace, or the cluster-scoped ones if namespace is empty.
+func resourcesView(reader *mustgather.Reader, namespace string) (*view, error) {
+	resources, err := reader.Resources(namespace)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	var rows []row
+	for _, gr := range resources {
+		rows = append(rows, row{cells: []string{gr.Resource, gr.Group}, key: gr.String(), namespace: namespace, resource: gr})
+	}
+	title := "cluster-scoped resources"
+	if namespace != "" {
+		title = "resources in namespace " + namespace
+	}
+	return newListView(title, resourceList, []string{"NAME", "APIGROUP"}, rows), nil
+}
+
+// objectsView lists the objects of a resource in a namespace, with the columns printed by "omc get".
+func objectsView(reader *mustgather.Reader, gr schema.GroupResource, namespace string) (*view, error) {
+	if gr.Group != "" {
+		// makes the printer columns of custom resources known to the table generator
+		get.KindGroupNamespaced(gr.Resource + "." + gr.Group)
+	}
+	items, err := reader.List(gr.WithVersion(""), namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var header []string
+	var rows []row
+	for i := range items {
+		item := &items[i]
+		itemHeader, cells := tableCells(item)
+		if header == nil {
+			header = itemHeader
+		}
+		rows = append(rows, row{cells: cells, key: item.GetName(), namespace: item.GetNamespace(), resource: gr, object: item})
+	}
+	if header == nil {
+		header = []string{"NAME"}
+	}
+	title := gr.String()
+	if namespace != "" {
+		title += " in namespace " + namespace
+	}
+	return newListView(title, objectList, header, rows), nil
+}
+
+// tableCells returns the header and the cells of the row printed by "omc get" for an object,
+// or only its name if it cannot be printed.
+func tableCells(item *unstructured.Unstructured) ([]string, []string) {
+	rawObject, err := json.Marshal(item.Object)
+	if err != nil {
+		return []string{"NAME"}, []string{item.GetName()}
+	}
+	table, err := get.ObjectTable(*item, rawObject)
+	if err != nil || len(table.Rows) == 0 {
+		return []string{"NAME"}, []string{item.GetName()}
+	}
+	var header, cells []string
+	for i, column := range table.ColumnDefinitions {
+		if column.Priority != 0 || i >= len(table.Rows[0].Cells) {
+			continue
+		}
+		header = append(header, strings.ToUpper(column.Name))
+		cells = append(cells, fmt.Sprint(table.Rows[0].Cells[i]))
+	}
+	return header, cells
+}
+
+func yamlView(item *unstructured.Unstructured) (*view, error) {
+	data, err := yaml.Marshal(item.Object)
+	if err != nil {
+		return nil, err
+	}
+	return newTextView(objectName(item)+" yaml", string(data)), nil
+}
+
+func describeView(reader *mustgather.Reader, gr schema.GroupResource, item *unstructured.Unstructured) (*view, error) {
+	resourceType := gr.Resource
+	if gr.Group != "" {
+		resourceType += "." + gr.Group
+	}
+	var out bytes.Buffer
+	if err := describe.Describe(&out, reader.Root(), item.GetNamespace(), resourceType, item.GetName()); err != nil {
+		return nil, err
+	}
+	return newTextView(objectName(item)+" description", out.String()), nil
+}
+
+// eventsView lists the events involving an object, the oldest first.
+func eventsView(reader *mustgather.Reader, item *unstructured.Unstructured) (*view, error) {
+	eventList, err := reader.Events(item.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	var involving corev1.EventList
+	for _, e := range eventList.Items {
+		involved := e.InvolvedObject
+		if involved.Kind == item.GetKind() && involved.Name == item.GetName() && (item.GetNamespace() == "" || involved.Namespace == item.GetNamespace()) {
+			involving.Items = append(involving.Items, e)
+		}
+	}
+	if len(involving.Items) == 0 {
+		return newTextView(objectName(item)+" events", "No events found."), nil
+	}
+	events.SortEventList(&involving)
+	var out bytes.Buffer
+	w := tabwriter.NewWriter(&out, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "LAST SEEN\tTYPE\tREASON\tCOUNT\tMESSAGE")
+	for _, e := range involving.Items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n", helpers.GetAge(reader.Root(), events.GetLastTime(e)), e.Type, e.Reason, e.Count, strings.TrimSpace(e.Message))
+	}
+	w.Flush()
+	return newTextView(objectName(item)+" events", out.String()), nil
+}
+
+// logsView lists the current, previous and rotated logs stored for the containers of a pod.
+func logsView(reader *mustgather.Reader, item *unstructured.Unstructured) (*view, error) {
+	if item.GroupVersionKind().GroupKind() != (schema.GroupKind{Kind: "Pod"}) {
+		return nil, fmt.Errorf("logs are only stored for pods")
+	}
+	var pod corev1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &pod); err != nil {
+		return nil, err
+	}
+	var containers []string
+	for _, c := range pod.Spec.Containers {
+		containers = append(containers, c.Name)
+	}
+	for _, c := range pod.Spec.InitContainers {
+		containers = append(containers, c.Name)
+	}
+	var rows []row
+	for _, container := range containers {
+		for _, opts := range []struct {
+			name string
+			mustgather.PodLogOptions
+		}{
+			{name: "current", PodLogOptions: mustgather.PodLogOptions{Container: container}},
+			{name: "previous", PodLogOptions: mustgather.PodLogOptions{Container: container, Previous: true}},
+			{name: "rotated", PodLogOptions: mustgather.PodLogOptions{Container: container, Rotated: true}},
+		} {
+			containerLogs, err := reader.PodLogs(pod.Namespace, pod.Name, opts.PodLogOptions)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range containerLogs {
+				if logStored(c) {
+					rows = append(rows, row{cells: []string{c.Container, opts.name}, key: c.Container + " " + opts.name, namespace: pod.Namespace, log: c})
+				}
+			}
+		}
+	}
+	return newListView(objectName(item)+" logs", logList, []string{"CONTAINER", "LOG"}, rows), nil
+}
+
+// logStored reports whether any of the log files of a container is part of the must-gather.
+func logStored(c mustgather.ContainerLog) bool {
+	for _, f := range c.Files {
+		if _, err := os.Stat(filepath.Join(c.Dir, f)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// logView shows the logs of a container, scrolled to their end.
+func logView(c mustgather.ContainerLog) (*view, error) {
+	var out bytes.Buffer
+	if err := logs.NewContainerLogReader(c).Read(&out); err != nil {
+		return nil, err
+	}
+	v := newTextView(fmt.Sprintf("pod/%s container %s logs", c.Pod, c.Container), out.String())
+	v.offset = len(v.lines)
+	return v, nil
+}
+
+// relatedView lists the objects related to an object: its owners, the node of a pod and
+// the pods scheduled on a node.
+func relatedView(reader *mustgather.Reader, item *unstructured.Unstructured) (*view, error) {
+	var rows []row
+	for _, ref := range item.GetOwnerReferences() {
+		gr, owner, err := getByKind(reader, ref.APIVersion, ref.Kind, item.GetNamespace(), ref.Name)
+		if err != nil {
+			klog.V(3).ErrorS(err, "Skipping owner", "kind", ref.Kind, "name", ref.Name)
+			continue
+		}
+		rows = append(rows, relatedRow("owner", gr, owner))
+	}
+	switch item.GroupVersionKind().GroupKind() {
+	case schema.GroupKind{Kind: "Pod"}:
+		nodeName, _, _ := unstructured.NestedString(item.Object, "spec", "nodeName")
+		if nodeName != "" {
+			node, err := reader.Get(nodesResource.WithVersion(""), "", nodeName)
+			if err != nil {
+				klog.V(3).ErrorS(err, "Skipping node", "name", nodeName)
+			} else {
+				rows = append(rows, relatedRow("node", nodesResource, node))
+			}
+		}
+	case schema.GroupKind{Kind: "Node"}:
+		pods, err := reader.List(podsResource.WithVersion(""), "", metav1.ListOptions{FieldSelector: "spec.nodeName=" + item.GetName()})
+		if err != nil {
+			return nil, err
+		}
+		for i := range pods {
+			rows = append(rows, relatedRow("scheduled pod", podsResource, &pods[i]))
+		}
+	}
+	return newListView("objects related to "+objectName(item), objectList, []string{"KIND", "NAMESPACE", "NAME", "RELATION"}, rows), nil
+}
+
+func relatedRow(relation string, gr schema.GroupResource, item *unstructured.Unstructured) row {
+	return row{
+		cells:     []string{item.GetKind(), item.GetNamespace(), item.GetName(), relation},
+		key:       item.GetNamespace() + "/" + item.GetName(),
+		namespace: item.GetNamespace(),
+		resource:  gr,
+		object:    item,
+	}
+}
+
+// getByKind returns an object referenced by its API version and kind, e.g. by an owner reference.
+func getByKind(reader *mustgather.Reader, apiVersion string, kind string, namespace string, name string) (schema.GroupResource, *unstructured.Unstructured, error) {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return schema.GroupResource{}, nil, err
+	}
+	alias := strings.ToLower(kind)
+	if gv.Group != "" {
+		alias += "." + gv.Group
+	}
+	plural, group, _, _, err := get.KindGroupNamespaced(alias)
+	if err != nil {
+		return schema.GroupResource{}, nil, err
+	}
+	if group == "core" {
+		group = ""
+	}
+	gr := schema.GroupResource{Group: group, Resource: plural}
+	item, err := reader.Get(gr.WithVersion(""), namespace, name)
+	return gr, item, err
+}
+
+// objectName returns the kind/name of an object, prefixed by its namespace if any.
+func objectName(item *unstructured.Unstructured) string {
+	name := strings.ToLower(item.GetKind()) + "/" + item.GetName()
+	if item.GetNamespace() != "" {
+		name = item.GetNamespace() + " " + name
+	}
+	return name
+}
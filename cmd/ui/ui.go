@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package ui
+
+import (
+	"fmt"
+
+	"github.com/gmeghnag/omc/pkg/mustgather"
+	"github.com/gmeghnag/omc/vars"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+)
+
+var UICmd = &cobra.Command{
+	Use:   "ui",
+	Short: "Browse the must-gather in an interactive terminal UI.",
+	Long: `Browse the namespaces, resources and objects of the must-gather in use in an interactive
+terminal UI. From an object you can open its YAML, its description, its events, the current,
+previous and rotated logs of its containers, and jump to related objects: owners, the node of
+a pod and the pods scheduled on a node. Every list can be narrowed with a fuzzy search.`,
+	Example: `  omc ui
+  omc ui -n openshift-etcd`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if vars.MustGatherRootPath == "" {
+			return fmt.Errorf("there are no must-gather resources defined, use \"omc use\" first")
+		}
+		reader := mustgather.NewReader(vars.MustGatherRootPath, mustgather.WithParallelism(vars.Parallelism))
+		m, err := newModel(reader, vars.Namespace)
+		if err != nil {
+			return err
+		}
+		_, err = tea.NewProgram(m, tea.WithAltScreen()).Run()
+		return err
+	},
+}
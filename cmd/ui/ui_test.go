@@ -0,0 +1,245 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package ui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gmeghnag/omc/internal/testutil"
+	"github.com/gmeghnag/omc/pkg/mustgather"
+	"github.com/gmeghnag/omc/vars"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func newUIFixture(t *testing.T) model {
+	root := testutil.MustGather(t, map[string]string{
+		"namespaces/ns1/ns1.yaml": testutil.Namespace("ns1"),
+		"namespaces/ns2/ns2.yaml": testutil.Namespace("ns2"),
+		"namespaces/ns1/apps/replicasets.yaml": `apiVersion: v1
+kind: List
+items:
+- apiVersion: apps/v1
+  kind: ReplicaSet
+  metadata:
+    name: web-abc
+    namespace: ns1
+  spec:
+    selector:
+      matchLabels:
+        app: web
+`,
+		"namespaces/ns1/pods/web-abc-1/web-abc-1.yaml": `apiVersion: v1
+kind: Pod
+metadata:
+  name: web-abc-1
+  namespace: ns1
+  labels:
+    app: web
+  ownerReferences:
+  - apiVersion: apps/v1
+    kind: ReplicaSet
+    name: web-abc
+    uid: rs1
+    controller: true
+spec:
+  nodeName: master-0
+  containers:
+  - name: web
+  - name: proxy
+status:
+  phase: Running
+`,
+		"namespaces/ns1/pods/web-abc-1/web/web/logs/current.log":  "current line\n",
+		"namespaces/ns1/pods/web-abc-1/web/web/logs/previous.log": "previous line\n",
+		"namespaces/ns1/core/events.yaml": `apiVersion: v1
+kind: EventList
+items:
+- apiVersion: v1
+  kind: Event
+  metadata:
+    name: web-abc-1.1
+    namespace: ns1
+  involvedObject:
+    kind: Pod
+    name: web-abc-1
+    namespace: ns1
+  reason: Started
+  type: Normal
+  message: Started container web
+  count: 1
+`,
+		"cluster-scoped-resources/core/nodes/master-0.yaml": `apiVersion: v1
+kind: Node
+metadata:
+  name: master-0
+`,
+	})
+	mustGatherRootPath := vars.MustGatherRootPath
+	vars.MustGatherRootPath = root
+	t.Cleanup(func() { vars.MustGatherRootPath = mustGatherRootPath })
+
+	m, err := newModel(mustgather.NewReader(root), "ns1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// press sends keys to the model, multi-rune keys are typed at once.
+func press(m model, keys ...string) model {
+	for _, k := range keys {
+		var msg tea.KeyMsg
+		switch k {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		case "backspace":
+			msg = tea.KeyMsg{Type: tea.KeyBackspace}
+		case "down":
+			msg = tea.KeyMsg{Type: tea.KeyDown}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		}
+		next, _ := m.Update(msg)
+		m = next.(model)
+	}
+	return m
+}
+
+func visibleKeys(v *view) []string {
+	var keys []string
+	for _, i := range v.visible {
+		keys = append(keys, v.rows[i].key)
+	}
+	return keys
+}
+
+func TestNavigation(t *testing.T) {
+	m := newUIFixture(t)
+	if r, _ := m.current().selected(); r.namespace != "ns1" {
+		t.Fatalf("expected namespace ns1 to be selected, got %q", r.namespace)
+	}
+
+	m = press(m, "enter")
+	if want := []string{"events", "pods", "replicasets.apps"}; !reflect.DeepEqual(visibleKeys(m.current()), want) {
+		t.Fatalf("expected resources %v, got %v", want, visibleKeys(m.current()))
+	}
+
+	m = press(m, "/", "pods", "enter", "enter")
+	v := m.current()
+	if v.title != "pods in namespace ns1" {
+		t.Fatalf("expected the pods of ns1, got %q", v.title)
+	}
+	if want := []string{"NAME", "READY", "STATUS", "RESTARTS", "AGE"}; !reflect.DeepEqual(v.header, want) {
+		t.Errorf("expected header %v, got %v", want, v.header)
+	}
+
+	tests := []struct {
+		name  string
+		keys  []string
+		title string
+		want  string
+	}{
+		{name: "yaml", keys: []string{"y"}, title: "ns1 pod/web-abc-1 yaml", want: "nodeName: master-0"},
+		{name: "describe", keys: []string{"d"}, title: "ns1 pod/web-abc-1 description", want: "Controlled By:  ReplicaSet/web-abc"},
+		{name: "events", keys: []string{"e"}, title: "ns1 pod/web-abc-1 events", want: "Started container web"},
+		{name: "current logs", keys: []string{"l", "enter"}, title: "pod/web-abc-1 container web logs", want: "current line"},
+		{name: "previous logs", keys: []string{"l", "down", "enter"}, title: "pod/web-abc-1 container web logs", want: "previous line"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			next := press(m, tc.keys...)
+			if next.status != "" {
+				t.Fatalf("unexpected error: %s", next.status)
+			}
+			v := next.current()
+			if !v.text || v.title != tc.title {
+				t.Fatalf("expected text view %q, got %q", tc.title, v.title)
+			}
+			if text := strings.Join(v.lines, "\n"); !strings.Contains(text, tc.want) {
+				t.Errorf("expected %q in:\n%s", tc.want, text)
+			}
+			for len(next.stack) > len(m.stack) {
+				next = press(next, "esc")
+			}
+			if next.current() != m.current() {
+				t.Errorf("expected esc to go back to the pods")
+			}
+		})
+	}
+
+	t.Run("logs", func(t *testing.T) {
+		next := press(m, "l")
+		if want := []string{"web current", "web previous"}; !reflect.DeepEqual(visibleKeys(next.current()), want) {
+			t.Errorf("expected logs %v, got %v", want, visibleKeys(next.current()))
+		}
+	})
+
+	t.Run("related", func(t *testing.T) {
+		next := press(m, "r")
+		if want := []string{"ns1/web-abc", "/master-0"}; !reflect.DeepEqual(visibleKeys(next.current()), want) {
+			t.Fatalf("expected related objects %v, got %v", want, visibleKeys(next.current()))
+		}
+		next = press(next, "down", "r")
+		if want := []string{"ns1/web-abc-1"}; !reflect.DeepEqual(visibleKeys(next.current()), want) {
+			t.Errorf("expected the pods of the node %v, got %v", want, visibleKeys(next.current()))
+		}
+	})
+
+	t.Run("logs of a node", func(t *testing.T) {
+		next := press(m, "r", "down", "l")
+		if next.status != "logs are only stored for pods" {
+			t.Errorf("expected an error, got %q", next.status)
+		}
+	})
+}
+
+func TestSearch(t *testing.T) {
+	m := newUIFixture(t)
+	m = press(m, "/", "n2")
+	if !m.searching {
+		t.Fatalf("expected the query to be typed")
+	}
+	if want := []string{"ns2"}; !reflect.DeepEqual(visibleKeys(m.current()), want) {
+		t.Errorf("expected %v, got %v", want, visibleKeys(m.current()))
+	}
+	if view := m.View(); !strings.Contains(view, "/n2") {
+		t.Errorf("expected the query in the view:\n%s", view)
+	}
+	m = press(m, "backspace", "enter")
+	if want := []string{"ns1", "ns2"}; !reflect.DeepEqual(visibleKeys(m.current()), want) {
+		t.Errorf("expected %v, got %v", want, visibleKeys(m.current()))
+	}
+	m = press(m, "esc")
+	if len(visibleKeys(m.current())) != 3 {
+		t.Errorf("expected esc to clear the query, got %v", visibleKeys(m.current()))
+	}
+}
+
+func TestFuzzyScore(t *testing.T) {
+	tests := []struct {
+		pattern string
+		s       string
+		match   bool
+	}{
+		{pattern: "", s: "pods", match: true},
+		{pattern: "kas", s: "kube-apiserver", match: true},
+		{pattern: "KAS", s: "kube-apiserver", match: true},
+		{pattern: "sak", s: "kube-apiserver", match: false},
+		{pattern: "pods", s: "pod", match: false},
+	}
+	for _, tc := range tests {
+		if _, ok := fuzzyScore(tc.pattern, tc.s); ok != tc.match {
+			t.Errorf("fuzzyScore(%q, %q): expected %v, got %v", tc.pattern, tc.s, tc.match, ok)
+		}
+	}
+	best, _ := fuzzyScore("kas", "kube-apiserver")
+	other, _ := fuzzyScore("kas", "kube-scheduler-pass")
+	if best <= other {
+		t.Errorf("expected kube-apiserver to score higher than kube-scheduler-pass, got %d and %d", best, other)
+	}
+}
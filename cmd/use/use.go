@@ -24,6 +24,7 @@ import (
 	"strings"
 
 	"github.com/gmeghnag/omc/cmd/helpers"
+	"github.com/gmeghnag/omc/pkg/index"
 	"github.com/gmeghnag/omc/types"
 	"github.com/gmeghnag/omc/vars"
 
@@ -34,6 +35,7 @@ import (
 )
 
 var singleNamespaceInMustGather bool
+var buildIndex bool
 
 // findExistingContextByRootDir checks if a root directory is already in the contexts
 func findExistingContextByRootDir(rootDir string, contexts []types.Context) (string, bool) {
@@ -201,6 +203,10 @@ func MustGatherInfo() {
 			fmt.Printf("ClusterVersion : %s\n", clusterversion)
 		}
 	}
+	if index.Lookup(vars.MustGatherRootPath) != nil {
+		indexDir, _ := index.Dir(vars.MustGatherRootPath)
+		fmt.Printf("Index          : %s\n", indexDir)
+	}
 	mustGatherSplitPath := strings.Split(vars.MustGatherRootPath, "/")
 	mustGatherParentPath := strings.Join(mustGatherSplitPath[0:(len(mustGatherSplitPath)-1)], "/")
 	clientVersion := extractClientVersion(mustGatherParentPath + "/must-gather.logs")
@@ -233,6 +239,12 @@ var UseCmd = &cobra.Command{
 		fileType := ""
 		isCompressedFile := false
 		if len(args) == 0 && idFlag == "" {
+			if buildIndex {
+				if err := index.Build(vars.MustGatherRootPath); err != nil {
+					fmt.Fprintln(os.Stderr, "Error: unable to index "+vars.MustGatherRootPath+": "+err.Error())
+					os.Exit(1)
+				}
+			}
 			MustGatherInfo()
 			os.Exit(0)
 		}
@@ -337,10 +349,17 @@ var UseCmd = &cobra.Command{
 			fmt.Fprintln(os.Stderr, err)
 			os.Exit(1)
 		}
+		if buildIndex {
+			if err := index.Build(vars.MustGatherRootPath); err != nil {
+				fmt.Fprintln(os.Stderr, "Error: unable to index "+vars.MustGatherRootPath+": "+err.Error())
+				os.Exit(1)
+			}
+		}
 		MustGatherInfo()
 	},
 }
 
 func init() {
 	UseCmd.Flags().StringVarP(&vars.Id, "id", "i", "", "Id string for the must-gather to use. If two must-gather has the same id the first one will be used.")
+	UseCmd.Flags().BoolVar(&buildIndex, "index", false, "Build (or rebuild) an on-disk index of the must-gather, to speed up the commands reading its resources.")
 }
@@ -0,0 +1,54 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package testutil writes the must-gathers the tests of omc are run against.
+package testutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// MustGather writes the files of a must-gather, keyed by their path relative to its root, in a
+// temporary directory and returns the root. HOME is set to an empty directory, so that the
+// resources and config of ~/.omc of the user running the tests are not read.
+func MustGather(t testing.TB, files map[string]string) string {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	root := t.TempDir()
+	WriteFiles(t, root, files)
+	return root
+}
+
+// WriteFiles writes files, keyed by their path relative to dir, creating their directories.
+func WriteFiles(t testing.TB, dir string, files map[string]string) {
+	t.Helper()
+	for path, content := range files {
+		fullPath := filepath.Join(dir, path)
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(fullPath, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// Namespace returns the manifest of a namespace, as gathered in namespaces/<name>/<name>.yaml.
+func Namespace(name string) string {
+	return "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: " + name + "\n"
+}
@@ -0,0 +1,637 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package index implements an optional on-disk cache of the objects stored in a
+// must-gather, so that commands can resolve them without walking the directory
+// tree and unmarshaling YAML on every invocation.
+//
+// The index is made of gob encoded shards, one per group/resource of every namespace,
+// namespaces/<namespace>/<group>_<resource>.gob, and of the cluster-scoped resources,
+// cluster-scoped-resources/<group>_<resource>.gob, so that a lookup only reads the
+// objects of the resource it is after. A shard holds the JSON encoding of the objects
+// keyed by name, and records the modification time and size of the files it was built
+// from: a stale or missing shard is reported as not found, and callers fall back to
+// reading the must-gather. Each directory also has a listing, resources.gob, recording the
+// modification time of the directory and of its subdirectories and the resources that could
+// not be indexed, so that a resource without shard is known to have no objects.
+package index
+
+import (
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// version is bumped whenever the shard format changes, invalidating existing indexes.
+const version = 3
+
+const (
+	manifestFile     = "manifest.gob"
+	listingFile      = "resources.gob"
+	clusterScopedDir = "cluster-scoped-resources"
+	namespacesDir    = "namespaces"
+)
+
+type manifest struct {
+	Version int
+	Root    string
+}
+
+// resources maps "<group>/<resource>" to the objects of the resource in a namespace, or in
+// the cluster-scoped resources, nil for the resources that could not be indexed.
+type resources map[string]*entry
+
+// entry is the shard of the objects of a resource. The listing of a directory is an entry
+// without objects, whose names are the resources of the directory that could not be indexed.
+type entry struct {
+	Sources []source
+	Names   []string
+	Objects [][]byte
+}
+
+type source struct {
+	Path    string
+	ModTime int64
+	Size    int64
+}
+
+// Index gives access to the index of a must-gather, loading its shards on demand.
+// A nil *Index is valid and behaves as an empty index.
+type Index struct {
+	root string
+	dir  string
+
+	mu     sync.Mutex
+	shards map[string]*entry
+}
+
+var (
+	lookupMu sync.Mutex
+	lookups  = map[string]*Index{}
+)
+
+// Dir returns the directory holding the index of the must-gather rooted in root.
+func Dir(root string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(filepath.Clean(abs)))
+	return filepath.Join(home, ".omc", "index", hex.EncodeToString(sum[:8])), nil
+}
+
+// Open returns the index of the must-gather rooted in root, or an error if none was built.
+func Open(root string) (*Index, error) {
+	dir, err := Dir(root)
+	if err != nil {
+		return nil, err
+	}
+	m := manifest{}
+	if err := readGob(filepath.Join(dir, manifestFile), &m); err != nil {
+		return nil, err
+	}
+	if m.Version != version {
+		return nil, fmt.Errorf("index %s has version %d, expected %d", dir, m.Version, version)
+	}
+	return &Index{root: root, dir: dir, shards: map[string]*entry{}}, nil
+}
+
+// Lookup returns the index of the must-gather rooted in root, or nil if none was built.
+// Indexes are opened once per process.
+func Lookup(root string) *Index {
+	if root == "" {
+		return nil
+	}
+	lookupMu.Lock()
+	defer lookupMu.Unlock()
+	if ix, ok := lookups[root]; ok {
+		return ix
+	}
+	ix, err := Open(root)
+	if err != nil {
+		ix = nil
+	}
+	lookups[root] = ix
+	return ix
+}
+
+// Remove deletes the index of the must-gather rooted in root, if any.
+func Remove(root string) error {
+	dir, err := Dir(root)
+	if err != nil {
+		return err
+	}
+	lookupMu.Lock()
+	delete(lookups, root)
+	lookupMu.Unlock()
+	return os.RemoveAll(dir)
+}
+
+// Namespaced returns the objects of the given group and resource stored in a namespace.
+// The second return value is false when the index does not hold them or is stale.
+func (ix *Index) Namespaced(namespace string, group string, resource string) ([]unstructured.Unstructured, bool) {
+	e := ix.entry(shardFile(filepath.Join(namespacesDir, namespace), group, resource))
+	if e == nil {
+		return nil, false
+	}
+	return decodeAll(e)
+}
+
+// ClusterScoped returns the cluster-scoped objects of the given group and resource.
+// The second return value is false when the index does not hold them or is stale.
+func (ix *Index) ClusterScoped(group string, resource string) ([]unstructured.Unstructured, bool) {
+	e := ix.entry(shardFile(clusterScopedDir, group, resource))
+	if e == nil {
+		return nil, false
+	}
+	return decodeAll(e)
+}
+
+// Get returns a single namespaced object, or a cluster-scoped one when namespace is empty.
+// The second return value is false when the index does not hold the objects of the
+// given group and resource, or is stale; an indexed but missing object returns
+// an empty object and true.
+func (ix *Index) Get(namespace string, group string, resource string, name string) (unstructured.Unstructured, bool) {
+	dir := clusterScopedDir
+	if namespace != "" {
+		dir = filepath.Join(namespacesDir, namespace)
+	}
+	e := ix.entry(shardFile(dir, group, resource))
+	if e == nil {
+		return unstructured.Unstructured{}, false
+	}
+	for i, n := range e.Names {
+		if n == name {
+			item := unstructured.Unstructured{}
+			if err := item.UnmarshalJSON(e.Objects[i]); err != nil {
+				return item, false
+			}
+			return item, true
+		}
+	}
+	return unstructured.Unstructured{}, true
+}
+
+// Count returns the number of objects of the given group and resource stored in a namespace,
+// or of the cluster-scoped ones when namespace is empty, without decoding them. A resource
+// without shard has no objects, unless the namespace changed since the index was built.
+// The second return value is false when the index does not hold them or is stale.
+func (ix *Index) Count(namespace string, group string, resource string) (int, bool) {
+	dir := clusterScopedDir
+	if namespace != "" {
+		dir = filepath.Join(namespacesDir, namespace)
+	}
+	if e := ix.load(shardFile(dir, group, resource)); e != nil {
+		if !fresh(ix.root, e.Sources) {
+			return 0, false
+		}
+		return len(e.Objects), true
+	}
+	listing := ix.entry(filepath.Join(dir, listingFile))
+	if listing == nil || slices.Contains(listing.Names, group+"/"+resource) {
+		return 0, false
+	}
+	return 0, true
+}
+
+// shardFile returns the path of the shard of a resource, relative to the index directory.
+func shardFile(dir string, group string, resource string) string {
+	return filepath.Join(dir, group+"_"+resource+".gob")
+}
+
+// entry returns the shard of a file if it is fresh.
+func (ix *Index) entry(file string) *entry {
+	e := ix.load(file)
+	if e == nil || !fresh(ix.root, e.Sources) {
+		return nil
+	}
+	return e
+}
+
+// load returns the shard of a file, read once, or nil if there is none.
+func (ix *Index) load(file string) *entry {
+	if ix == nil {
+		return nil
+	}
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+	e, ok := ix.shards[file]
+	if !ok {
+		e = &entry{}
+		if err := readGob(filepath.Join(ix.dir, file), e); err != nil {
+			e = nil
+		}
+		ix.shards[file] = e
+	}
+	return e
+}
+
+func decodeAll(e *entry) ([]unstructured.Unstructured, bool) {
+	items := make([]unstructured.Unstructured, 0, len(e.Objects))
+	for _, raw := range e.Objects {
+		item := unstructured.Unstructured{}
+		if err := item.UnmarshalJSON(raw); err != nil {
+			return nil, false
+		}
+		items = append(items, item)
+	}
+	return items, true
+}
+
+func fresh(root string, sources []source) bool {
+	for _, s := range sources {
+		fi, err := os.Stat(filepath.Join(root, s.Path))
+		if err != nil || fi.ModTime().UnixNano() != s.ModTime || fi.Size() != s.Size {
+			return false
+		}
+	}
+	return true
+}
+
+// Build indexes the must-gather rooted in root, replacing any existing index.
+func Build(root string) error {
+	dir, err := Dir(root)
+	if err != nil {
+		return err
+	}
+	tmpDir := dir + ".tmp"
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return err
+	}
+
+	var namespaces []string
+	entries, err := os.ReadDir(filepath.Join(root, "namespaces"))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for _, e := range entries {
+		if e.IsDir() && !strings.HasPrefix(e.Name(), ".") {
+			namespaces = append(namespaces, e.Name())
+		}
+	}
+
+	jobs := make(chan string)
+	errs := make(chan error, len(namespaces))
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for namespace := range jobs {
+				r, err := buildNamespace(root, namespace)
+				if err == nil {
+					err = writeShards(root, tmpDir, filepath.Join(namespacesDir, namespace), r)
+				}
+				if err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+	for _, namespace := range namespaces {
+		jobs <- namespace
+	}
+	close(jobs)
+	wg.Wait()
+	close(errs)
+	if err := <-errs; err != nil {
+		return err
+	}
+
+	r, err := buildGroups(root, "cluster-scoped-resources")
+	if err != nil {
+		return err
+	}
+	if err := writeShards(root, tmpDir, clusterScopedDir, r); err != nil {
+		return err
+	}
+	if err := writeGob(filepath.Join(tmpDir, manifestFile), manifest{Version: version, Root: root}); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpDir, dir); err != nil {
+		return err
+	}
+	lookupMu.Lock()
+	delete(lookups, root)
+	lookupMu.Unlock()
+	return nil
+}
+
+// writeShards writes the shards of the resources of dir, relative to root, and its listing in
+// the index directory indexDir.
+func writeShards(root string, indexDir string, dir string, r resources) error {
+	if err := os.MkdirAll(filepath.Join(indexDir, dir), 0o755); err != nil {
+		return err
+	}
+	listing, err := listDir(root, dir)
+	if err != nil {
+		return err
+	}
+	for key, e := range r {
+		if e == nil {
+			listing.Names = append(listing.Names, key)
+			continue
+		}
+		group, resource, _ := strings.Cut(key, "/")
+		if err := writeGob(filepath.Join(indexDir, shardFile(dir, group, resource)), e); err != nil {
+			return err
+		}
+	}
+	return writeGob(filepath.Join(indexDir, dir, listingFile), listing)
+}
+
+// listDir returns the listing of dir, relative to root, without its unindexed resources:
+// adding a resource to the directory or to one of its groups changes their modification time.
+func listDir(root string, dir string) (*entry, error) {
+	listing := &entry{}
+	src, err := stat(root, dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// a must-gather without cluster-scoped resources
+			return listing, nil
+		}
+		return nil, err
+	}
+	listing.Sources = append(listing.Sources, src)
+	subdirs, err := os.ReadDir(filepath.Join(root, dir))
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range subdirs {
+		if !d.IsDir() {
+			continue
+		}
+		src, err := stat(root, filepath.Join(dir, d.Name()))
+		if err != nil {
+			return nil, err
+		}
+		listing.Sources = append(listing.Sources, src)
+	}
+	return listing, nil
+}
+
+func buildNamespace(root string, namespace string) (resources, error) {
+	namespaceDir := filepath.Join("namespaces", namespace)
+	r, err := buildGroups(root, namespaceDir)
+	if err != nil {
+		return nil, err
+	}
+	// the namespace object itself is stored in "namespaces/<namespace>/<namespace>.yaml"
+	if e, err := objectsFromFiles(root, []string{filepath.Join(namespaceDir, namespace+".yaml")}); err == nil {
+		r["core/namespaces"] = e
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	// core/pods.yaml may be empty or missing, in which case the pods are read from the pods directory
+	if listed := r["core/pods"]; listed == nil || len(listed.Objects) == 0 {
+		e, err := podsFromDir(root, filepath.Join(namespaceDir, "pods"))
+		if err != nil {
+			return nil, err
+		}
+		if e != nil {
+			if listed != nil {
+				e.Sources = append(e.Sources, listed.Sources...)
+			}
+			r["core/pods"] = e
+		}
+	}
+	return r, nil
+}
+
+// buildGroups indexes the "<group>/<resource>.yaml" lists and "<group>/<resource>/<name>.yaml"
+// files found in dir, relative to root.
+func buildGroups(root string, dir string) (resources, error) {
+	r := resources{}
+	groups, err := os.ReadDir(filepath.Join(root, dir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return r, nil
+		}
+		return nil, err
+	}
+	for _, g := range groups {
+		if !g.IsDir() || g.Name() == "pods" {
+			continue
+		}
+		groupResources, err := os.ReadDir(filepath.Join(root, dir, g.Name()))
+		if err != nil {
+			return nil, err
+		}
+		lists := map[string]*entry{}
+		dirs := map[string]*entry{}
+		for _, res := range groupResources {
+			var err error
+			switch {
+			case res.IsDir():
+				var e *entry
+				if e, err = objectsFromDir(root, filepath.Join(dir, g.Name(), res.Name())); err == nil {
+					dirs[res.Name()] = e
+				}
+			case strings.HasSuffix(res.Name(), ".yaml"):
+				var e *entry
+				if e, err = objectsFromList(root, filepath.Join(dir, g.Name(), res.Name())); err == nil {
+					lists[strings.TrimSuffix(res.Name(), ".yaml")] = e
+				}
+			}
+			if err != nil {
+				// unreadable resources are not indexed, commands report the error when reading them
+				r[g.Name()+"/"+strings.TrimSuffix(res.Name(), ".yaml")] = nil
+			}
+		}
+		for resource, e := range dirs {
+			r[g.Name()+"/"+resource] = e
+		}
+		for resource, e := range lists {
+			// a list takes precedence over the directory, unless it is empty
+			if d, ok := dirs[resource]; ok && len(e.Objects) == 0 && len(d.Objects) > 0 {
+				continue
+			}
+			r[g.Name()+"/"+resource] = e
+		}
+	}
+	return r, nil
+}
+
+func objectsFromList(root string, path string) (*entry, error) {
+	src, err := stat(root, path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(root, path))
+	if err != nil {
+		return nil, err
+	}
+	e := &entry{Sources: []source{src}}
+	if len(data) == 0 {
+		return e, nil
+	}
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling %s: %w", path, err)
+	}
+	list := struct {
+		Items []json.RawMessage `json:"items"`
+	}{}
+	if err := json.Unmarshal(jsonData, &list); err != nil {
+		return nil, fmt.Errorf("error unmarshaling %s: %w", path, err)
+	}
+	for _, raw := range list.Items {
+		if err := e.add(raw); err != nil {
+			return nil, fmt.Errorf("error unmarshaling %s: %w", path, err)
+		}
+	}
+	return e, nil
+}
+
+func objectsFromDir(root string, dir string) (*entry, error) {
+	src, err := stat(root, dir)
+	if err != nil {
+		return nil, err
+	}
+	files, err := os.ReadDir(filepath.Join(root, dir))
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, f := range files {
+		if !f.IsDir() && strings.HasSuffix(f.Name(), ".yaml") {
+			paths = append(paths, filepath.Join(dir, f.Name()))
+		}
+	}
+	e, err := objectsFromFiles(root, paths)
+	if err != nil {
+		return nil, err
+	}
+	// the directory modification time changes when resources are added or removed
+	e.Sources = append(e.Sources, src)
+	return e, nil
+}
+
+func podsFromDir(root string, podsDir string) (*entry, error) {
+	src, err := stat(root, podsDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	pods, err := os.ReadDir(filepath.Join(root, podsDir))
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, pod := range pods {
+		path := filepath.Join(podsDir, pod.Name(), pod.Name()+".yaml")
+		if _, err := os.Stat(filepath.Join(root, path)); pod.IsDir() && err == nil {
+			paths = append(paths, path)
+		}
+	}
+	e, err := objectsFromFiles(root, paths)
+	if err != nil {
+		return nil, err
+	}
+	e.Sources = append(e.Sources, src)
+	return e, nil
+}
+
+func objectsFromFiles(root string, paths []string) (*entry, error) {
+	sort.Strings(paths)
+	e := &entry{}
+	for _, path := range paths {
+		src, err := stat(root, path)
+		if err != nil {
+			return nil, err
+		}
+		data, err := os.ReadFile(filepath.Join(root, path))
+		if err != nil {
+			return nil, err
+		}
+		e.Sources = append(e.Sources, src)
+		jsonData, err := yaml.YAMLToJSON(data)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling %s: %w", path, err)
+		}
+		if string(jsonData) == "null" {
+			continue
+		}
+		if err := e.add(jsonData); err != nil {
+			return nil, fmt.Errorf("error unmarshaling %s: %w", path, err)
+		}
+	}
+	return e, nil
+}
+
+func (e *entry) add(raw []byte) error {
+	object := struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+	}{}
+	if err := json.Unmarshal(raw, &object); err != nil {
+		return err
+	}
+	e.Names = append(e.Names, object.Metadata.Name)
+	e.Objects = append(e.Objects, raw)
+	return nil
+}
+
+func stat(root string, path string) (source, error) {
+	fi, err := os.Stat(filepath.Join(root, path))
+	if err != nil {
+		return source{}, err
+	}
+	return source{Path: path, ModTime: fi.ModTime().UnixNano(), Size: fi.Size()}, nil
+}
+
+func readGob(path string, v interface{}) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return gob.NewDecoder(f).Decode(v)
+}
+
+func writeGob(path string, v interface{}) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := gob.NewEncoder(f).Encode(v); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
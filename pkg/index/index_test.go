@@ -0,0 +1,236 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package index
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gmeghnag/omc/internal/testutil"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newIndexFixture(t *testing.T) string {
+	root := testutil.MustGather(t, map[string]string{
+		"namespaces/ns1/ns1.yaml": testutil.Namespace("ns1"),
+		"namespaces/ns1/core/configmaps.yaml": `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: cm1
+    namespace: ns1
+  data:
+    replicas: "3"
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: cm2
+    namespace: ns1
+`,
+		"namespaces/ns1/core/pods.yaml": "",
+		"namespaces/ns1/pods/web-0/web-0.yaml": `apiVersion: v1
+kind: Pod
+metadata:
+  name: web-0
+  namespace: ns1
+spec:
+  containers:
+  - name: web
+    image: nginx
+`,
+		"namespaces/ns1/route.openshift.io/routes/web.yaml": `apiVersion: route.openshift.io/v1
+kind: Route
+metadata:
+  name: web
+  namespace: ns1
+spec:
+  port:
+    targetPort: 8080
+`,
+		"cluster-scoped-resources/core/nodes/master-0.yaml": `apiVersion: v1
+kind: Node
+metadata:
+  name: master-0
+`,
+	})
+	return root
+}
+
+func TestIndex(t *testing.T) {
+	root := newIndexFixture(t)
+	if Lookup(root) != nil {
+		t.Fatalf("expected no index before building it")
+	}
+	if err := Build(root); err != nil {
+		t.Fatal(err)
+	}
+	ix := Lookup(root)
+	if ix == nil {
+		t.Fatalf("expected an index after building it")
+	}
+
+	tests := []struct {
+		name      string
+		namespace string
+		group     string
+		resource  string
+		want      []string
+		wantOk    bool
+		// wantCounted is whether the index counts the objects, none for a resource without shard
+		wantCounted bool
+	}{
+		{name: "list file", namespace: "ns1", group: "core", resource: "configmaps", want: []string{"cm1", "cm2"}, wantOk: true, wantCounted: true},
+		{name: "empty pods list falls back to the pods directory", namespace: "ns1", group: "core", resource: "pods", want: []string{"web-0"}, wantOk: true, wantCounted: true},
+		{name: "one file per resource", namespace: "ns1", group: "route.openshift.io", resource: "routes", want: []string{"web"}, wantOk: true, wantCounted: true},
+		{name: "namespace object", namespace: "ns1", group: "core", resource: "namespaces", want: []string{"ns1"}, wantOk: true, wantCounted: true},
+		{name: "cluster scoped", group: "core", resource: "nodes", want: []string{"master-0"}, wantOk: true, wantCounted: true},
+		{name: "resource not in the must-gather", namespace: "ns1", group: "apps", resource: "deployments", wantOk: false, wantCounted: true},
+		{name: "namespace not in the must-gather", namespace: "ns2", group: "core", resource: "configmaps", wantOk: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			var ok bool
+			if tc.namespace == "" {
+				items, found := ix.ClusterScoped(tc.group, tc.resource)
+				for _, item := range items {
+					got = append(got, item.GetName())
+				}
+				ok = found
+			} else {
+				items, found := ix.Namespaced(tc.namespace, tc.group, tc.resource)
+				for _, item := range items {
+					got = append(got, item.GetName())
+				}
+				ok = found
+			}
+			if ok != tc.wantOk {
+				t.Fatalf("expected ok=%v, got %v", tc.wantOk, ok)
+			}
+			if n, ok := ix.Count(tc.namespace, tc.group, tc.resource); ok != tc.wantCounted || n != len(tc.want) {
+				t.Errorf("expected a count of %d (ok=%v), got %d (ok=%v)", len(tc.want), tc.wantCounted, n, ok)
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, got)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Fatalf("expected %v, got %v", tc.want, got)
+				}
+			}
+		})
+	}
+
+	t.Run("lookups only read the shard of their resource", func(t *testing.T) {
+		fresh, err := Open(root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := fresh.Namespaced("ns1", "core", "pods"); !ok {
+			t.Fatalf("expected the pods of ns1 to be indexed")
+		}
+		var loaded []string
+		for file := range fresh.shards {
+			loaded = append(loaded, file)
+		}
+		if want := filepath.Join("namespaces", "ns1", "core_pods.gob"); len(loaded) != 1 || loaded[0] != want {
+			t.Errorf("expected only %s to be loaded, got %v", want, loaded)
+		}
+	})
+
+	t.Run("get by name", func(t *testing.T) {
+		item, ok := ix.Get("ns1", "core", "configmaps", "cm1")
+		if !ok || item.GetName() != "cm1" {
+			t.Fatalf("expected configmap cm1, got %v (ok=%v)", item.Object, ok)
+		}
+		if replicas, _, _ := unstructured.NestedString(item.Object, "data", "replicas"); replicas != "3" {
+			t.Errorf("expected data.replicas=3, got %q", replicas)
+		}
+		item, ok = ix.Get("ns1", "core", "configmaps", "missing")
+		if !ok || item.Object != nil {
+			t.Fatalf("expected an indexed but missing object, got %v (ok=%v)", item.Object, ok)
+		}
+	})
+
+	t.Run("numbers keep their type", func(t *testing.T) {
+		item, _ := ix.Get("ns1", "route.openshift.io", "routes", "web")
+		port := item.Object["spec"].(map[string]interface{})["port"].(map[string]interface{})["targetPort"]
+		if _, ok := port.(int64); !ok {
+			t.Errorf("expected targetPort to be an int64, got %T", port)
+		}
+	})
+
+	t.Run("modified files invalidate their entries", func(t *testing.T) {
+		path := filepath.Join(root, "namespaces/ns1/core/configmaps.yaml")
+		later := time.Now().Add(time.Minute)
+		if err := os.Chtimes(path, later, later); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := ix.Namespaced("ns1", "core", "configmaps"); ok {
+			t.Errorf("expected a stale entry after modifying %s", path)
+		}
+		if _, ok := ix.Namespaced("ns1", "route.openshift.io", "routes"); !ok {
+			t.Errorf("expected the entries of unmodified files to be valid")
+		}
+	})
+
+	t.Run("added files invalidate their directory", func(t *testing.T) {
+		testutil.WriteFiles(t, root, map[string]string{
+			"cluster-scoped-resources/core/nodes/master-1.yaml": `apiVersion: v1
+kind: Node
+metadata:
+  name: master-1
+`,
+		})
+		later := time.Now().Add(time.Minute)
+		if err := os.Chtimes(filepath.Join(root, "cluster-scoped-resources/core/nodes"), later, later); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := ix.ClusterScoped("core", "nodes"); ok {
+			t.Errorf("expected a stale entry after adding a node")
+		}
+	})
+
+	t.Run("added resources are not counted", func(t *testing.T) {
+		if _, ok := ix.Count("ns1", "core", "secrets"); !ok {
+			t.Fatalf("expected the index to count the secrets of ns1")
+		}
+		testutil.WriteFiles(t, root, map[string]string{
+			"namespaces/ns1/core/secrets.yaml": "apiVersion: v1\nkind: List\nitems: []\n",
+		})
+		later := time.Now().Add(time.Minute)
+		if err := os.Chtimes(filepath.Join(root, "namespaces/ns1/core"), later, later); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := ix.Count("ns1", "core", "secrets"); ok {
+			t.Errorf("expected a stale listing after adding the secrets")
+		}
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		if err := Remove(root); err != nil {
+			t.Fatal(err)
+		}
+		if Lookup(root) != nil {
+			t.Errorf("expected no index after removing it")
+		}
+	})
+}
+
+func TestNilIndex(t *testing.T) {
+	var ix *Index
+	if _, ok := ix.Namespaced("ns1", "core", "pods"); ok {
+		t.Errorf("expected a nil index to hold nothing")
+	}
+	if _, ok := ix.Get("", "core", "nodes", "master-0"); ok {
+		t.Errorf("expected a nil index to hold nothing")
+	}
+	if _, ok := ix.Count("", "core", "nodes"); ok {
+		t.Errorf("expected a nil index to hold nothing")
+	}
+}
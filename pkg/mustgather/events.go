@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mustgather
+
+import (
+	"os"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// Events returns the events stored in a namespace, or in all namespaces if namespace is empty.
+// Namespaces whose events cannot be read are skipped.
+func (r *Reader) Events(namespace string) (*corev1.EventList, error) {
+	namespaces := []string{namespace}
+	if namespace == "" {
+		entries, err := os.ReadDir(filepath.Join(r.root, "namespaces"))
+		if err != nil {
+			return nil, err
+		}
+		namespaces = namespaces[:0]
+		for _, e := range entries {
+			namespaces = append(namespaces, e.Name())
+		}
+	}
+	eventList := &corev1.EventList{}
+	for _, ns := range namespaces {
+		if items, ok := r.index.Namespaced(ns, "core", "events"); ok {
+			for _, item := range items {
+				var event corev1.Event
+				if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &event); err != nil {
+					klog.V(3).ErrorS(err, "Unable to convert indexed event", "namespace", ns, "name", item.GetName())
+					continue
+				}
+				eventList.Items = append(eventList.Items, event)
+			}
+			if eventList.Kind == "" && len(items) > 0 {
+				eventList.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("EventList"))
+			}
+			continue
+		}
+		eventsPath := filepath.Join(r.root, "namespaces", ns, "core", "events.yaml")
+		eventsFile, err := os.ReadFile(eventsPath)
+		if err != nil {
+			klog.V(5).ErrorS(err, "Unable to read events.yaml")
+			continue
+		}
+		var nsEvents corev1.EventList
+		if err := yaml.Unmarshal(eventsFile, &nsEvents); err != nil {
+			klog.V(3).ErrorS(err, "Unable to parse Kubernetes EventList object from "+eventsPath)
+			continue
+		}
+		if eventList.Kind == "" {
+			eventList.SetGroupVersionKind(nsEvents.GroupVersionKind())
+		}
+		eventList.Items = append(eventList.Items, nsEvents.Items...)
+	}
+	return eventList, nil
+}
@@ -0,0 +1,311 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mustgather
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+// The logs of a container are stored in a directory such as
+// namespaces/<namespace>/pods/<pod>/<container>/<container>/logs/:
+//
+//	├── current.log
+//	├── previous.insecure.log
+//	├── previous.log
+//	└── rotated
+//	    ├── 0.log.xxx.gz
+//	    ├── 0.log.yyy.gz
+//	    └── 0.log.zzz
+const (
+	CurrentLogFile          = "current.log"
+	PreviousLogFile         = "previous.log"
+	PreviousInsecureLogFile = "previous.insecure.log"
+	RotatedLogDir           = "rotated"
+)
+
+// defaultContainerAnnotation names the container kubectl selects in pods with several containers.
+const defaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+
+// PodLogOptions selects the containers of a pod and which of their logs to read.
+type PodLogOptions struct {
+	// Container is the name of the container, it can be omitted for single container pods.
+	Container string
+	// AllContainers selects all the (non init) containers of the pod.
+	AllContainers bool
+	// DefaultContainer selects the default container of the pods with several containers when
+	// Container is empty, as kubectl does: the one named by their
+	// kubectl.kubernetes.io/default-container annotation, or their first one.
+	DefaultContainer bool
+	// Previous selects the logs of the previous instance of the containers.
+	Previous bool
+	// Rotated selects the rotated logs of the containers.
+	Rotated bool
+	// AllLogs selects the rotated, previous and current logs of the containers, in this order.
+	AllLogs bool
+	// Insecure selects the insecure siblings of the selected logs.
+	Insecure bool
+}
+
+// ContainerLog locates the log files of a container.
+type ContainerLog struct {
+	Pod       string
+	Container string
+	// Dir is the logs directory of the container.
+	Dir string
+	// Files are the log files to read, relative to Dir and in reading order; they may not exist.
+	Files []string
+}
+
+// PodLogs returns the logs of the containers of a pod selected by opts.
+func (r *Reader) PodLogs(namespace string, podName string, opts PodLogOptions) ([]ContainerLog, error) {
+	item, err := r.Get(schema.GroupVersionResource{Version: "v1", Resource: "pods"}, namespace, podName)
+	if err != nil {
+		return nil, err
+	}
+	var pod corev1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &pod); err != nil {
+		return nil, fmt.Errorf("error converting pod %s: %w", podName, err)
+	}
+	var containers []string
+	switch {
+	case len(pod.Spec.Containers) == 1 && opts.Container == "":
+		containers = []string{pod.Spec.Containers[0].Name}
+	case opts.AllContainers:
+		for _, c := range pod.Spec.Containers {
+			containers = append(containers, c.Name)
+		}
+	case opts.Container == "" && opts.DefaultContainer && len(pod.Spec.Containers) > 0:
+		containers = []string{pod.Spec.Containers[0].Name}
+		for _, c := range pod.Spec.Containers {
+			if c.Name == pod.Annotations[defaultContainerAnnotation] {
+				containers = []string{c.Name}
+			}
+		}
+	default:
+		var names []string
+		for _, c := range append(append([]corev1.Container{}, pod.Spec.Containers...), pod.Spec.InitContainers...) {
+			if opts.Container == c.Name {
+				containers = []string{c.Name}
+				break
+			}
+			names = append(names, c.Name)
+		}
+		if len(containers) == 0 {
+			if opts.Container != "" {
+				return nil, fmt.Errorf("container %s is not valid for pod %s", opts.Container, pod.Name)
+			}
+			return nil, fmt.Errorf("a container name must be specified for pod %s, choose one of: %v", pod.Name, names)
+		}
+	}
+	var logs []ContainerLog
+	for _, container := range containers {
+		dir := filepath.Join(r.root, "namespaces", namespace, "pods", pod.Name, container, container, "logs")
+		logs = append(logs, ContainerLog{Pod: pod.Name, Container: container, Dir: dir, Files: LogFiles(dir, opts)})
+	}
+	return logs, nil
+}
+
+// LogFiles returns the log files of a container logs directory selected by opts, relative to dir.
+func LogFiles(dir string, opts PodLogOptions) []string {
+	files := []string{CurrentLogFile}
+	if opts.Previous {
+		files = []string{PreviousLogFile}
+	}
+	if opts.Rotated {
+		files = RotatedLogFiles(dir)
+	}
+	if opts.AllLogs {
+		files = append(RotatedLogFiles(dir), PreviousLogFile, CurrentLogFile)
+	}
+	if opts.Insecure {
+		files = InsecureLogFiles(files)
+	}
+	return files
+}
+
+// RotatedLogFiles returns the plain and gzipped rotated log files of a container logs directory, relative to dir.
+func RotatedLogFiles(dir string) []string {
+	files := []string{}
+	err := filepath.WalkDir(filepath.Join(dir, RotatedLogDir),
+		func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.Type().IsRegular() {
+				files = append(files, filepath.Join(RotatedLogDir, d.Name()))
+			}
+			return nil
+		})
+	if err != nil && !os.IsNotExist(err) {
+		klog.V(1).ErrorS(err, "Unable to list rotated logs", "dir", dir)
+	}
+	// the files are sorted by the time they were rotated at rather than by the container
+	// restart count prefixing their name: <restart>.log.<yyyymmdd-hhmmss>[.gz]
+	sort.SliceStable(files, func(i, j int) bool {
+		ti, tj := rotationTime(files[i]), rotationTime(files[j])
+		if ti != tj {
+			return ti < tj
+		}
+		return files[i] < files[j]
+	})
+	return files
+}
+
+// rotationTime returns the rotation time of a rotated log file as yyyymmdd-hhmmss, which sorts
+// chronologically, or an empty string if its name has none.
+func rotationTime(file string) string {
+	_, suffix, ok := strings.Cut(filepath.Base(file), ".log.")
+	if !ok {
+		return ""
+	}
+	suffix = strings.TrimSuffix(suffix, ".gz")
+	if _, err := time.Parse("20060102-150405", suffix); err != nil {
+		return ""
+	}
+	return suffix
+}
+
+// InsecureLogFiles returns the insecure siblings of the given ".log" files, other files are dropped.
+func InsecureLogFiles(files []string) []string {
+	insecure := []string{}
+	for _, f := range files {
+		nf := strings.TrimSuffix(f, ".log")
+		if f != nf {
+			insecure = append(insecure, nf+".insecure.log")
+		}
+	}
+	return insecure
+}
+
+// OpenLogFile opens a log file, returning either a *os.File or a *gzip.Reader for gzipped ones.
+func OpenLogFile(path string) (io.ReadCloser, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		// after trying to read in a gzip.Reader, reset the offset to start
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			file.Close()
+			return nil, err
+		}
+		return file, nil
+	}
+	return reader, nil
+}
+
+// detachedBufferSize is the size of the reads of a detached log file, each read opening it.
+const detachedBufferSize = 64 * 1024
+
+// OpenDetachedLogFile opens a log file as OpenLogFile, without holding its file descriptor
+// between the reads, so that the logs of many containers can be read at once.
+func OpenDetachedLogFile(path string) (io.ReadCloser, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	file := &detachedFile{path: path}
+	buffered := bufio.NewReaderSize(file, detachedBufferSize)
+	reader, err := gzip.NewReader(buffered)
+	if err != nil {
+		// after trying to read in a gzip.Reader, reset the offset to start
+		file.offset = 0
+		buffered.Reset(file)
+		return io.NopCloser(buffered), nil
+	}
+	return reader, nil
+}
+
+// detachedFile reads a file from an offset, opening and closing it on each read.
+type detachedFile struct {
+	path   string
+	offset int64
+}
+
+func (f *detachedFile) Read(p []byte) (int, error) {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	n, err := file.ReadAt(p, f.offset)
+	f.offset += int64(n)
+	return n, err
+}
+
+// Open returns the concatenation of the log files of the container, skipping missing ones.
+func (c ContainerLog) Open() (io.ReadCloser, error) {
+	var readers []io.ReadCloser
+	for _, f := range c.Files {
+		reader, err := OpenLogFile(filepath.Join(c.Dir, f))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			for _, r := range readers {
+				r.Close()
+			}
+			return nil, fmt.Errorf("failed to open log file %s: %w", f, err)
+		}
+		readers = append(readers, reader)
+	}
+	return &multiReadCloser{readers: readers}, nil
+}
+
+type multiReadCloser struct {
+	readers []io.ReadCloser
+}
+
+func (m *multiReadCloser) Read(p []byte) (int, error) {
+	for len(m.readers) > 0 {
+		n, err := m.readers[0].Read(p)
+		if err == io.EOF {
+			m.readers[0].Close()
+			m.readers = m.readers[1:]
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+	return 0, io.EOF
+}
+
+func (m *multiReadCloser) Close() error {
+	var err error
+	for _, r := range m.readers {
+		if cerr := r.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	m.readers = nil
+	return err
+}
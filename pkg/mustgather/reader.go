@@ -0,0 +1,558 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mustgather reads the resources, events and logs stored in a must-gather,
+// without relying on any global state, so that it can be embedded in other tools:
+//
+//	r := mustgather.NewReader("/path/to/must-gather/quay-io-...")
+//	pods, err := r.List(schema.GroupVersionResource{Version: "v1", Resource: "pods"}, "openshift-etcd", metav1.ListOptions{LabelSelector: "app=etcd"})
+//
+// Resources are looked up by group and resource name only, as a must-gather stores a single
+// version of each of them; an empty group and the "core" group both refer to the core API group.
+package mustgather
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gmeghnag/omc/pkg/index"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+// Reader reads a must-gather rooted in a directory. It is safe for concurrent use.
+type Reader struct {
+	root        string
+	index       *index.Index
+	parallelism int
+}
+
+// Option configures a Reader.
+type Option func(*Reader)
+
+// WithParallelism sets the number of namespaces read concurrently, defaulting to the number of CPUs.
+func WithParallelism(parallelism int) Option {
+	return func(r *Reader) {
+		if parallelism > 0 {
+			r.parallelism = parallelism
+		}
+	}
+}
+
+// NewReader returns a Reader for the must-gather rooted in root, i.e. the directory holding
+// the "namespaces" and "cluster-scoped-resources" directories. The index built by
+// "omc use --index" is used when available.
+func NewReader(root string, opts ...Option) *Reader {
+	r := &Reader{
+		root:        root,
+		index:       index.Lookup(root),
+		parallelism: runtime.NumCPU(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Root returns the directory the must-gather is rooted in.
+func (r *Reader) Root() string {
+	return r.root
+}
+
+// Namespaces returns the names of the namespaces stored in the must-gather, sorted.
+func (r *Reader) Namespaces() ([]string, error) {
+	entries, err := readDirForResources(filepath.Join(r.root, "namespaces"))
+	if err != nil {
+		return nil, err
+	}
+	var namespaces []string
+	for _, e := range entries {
+		if e.IsDir() {
+			namespaces = append(namespaces, e.Name())
+		}
+	}
+	return namespaces, nil
+}
+
+// Resources returns the resources stored in a namespace, or the cluster-scoped ones if namespace
+// is empty, sorted by name and group.
+func (r *Reader) Resources(namespace string) ([]schema.GroupResource, error) {
+	dir := filepath.Join(r.root, "cluster-scoped-resources")
+	if namespace != "" {
+		dir = filepath.Join(r.root, "namespaces", namespace)
+	}
+	groups, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[schema.GroupResource]struct{})
+	var resources []schema.GroupResource
+	add := func(gr schema.GroupResource) {
+		if _, ok := seen[gr]; !ok {
+			seen[gr] = struct{}{}
+			resources = append(resources, gr)
+		}
+	}
+	for _, g := range groups {
+		if !g.IsDir() {
+			continue
+		}
+		if namespace != "" && g.Name() == "pods" {
+			// the directory holding the pods and their logs
+			add(schema.GroupResource{Resource: "pods"})
+			continue
+		}
+		entries, _ := readDirForResources(filepath.Join(dir, g.Name()))
+		for _, e := range entries {
+			gvr := schema.GroupVersionResource{Group: g.Name(), Resource: strings.TrimSuffix(e.Name(), ".yaml")}
+			add(schema.GroupResource{Group: apiGroup(gvr), Resource: gvr.Resource})
+		}
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].Resource != resources[j].Resource {
+			return resources[i].Resource < resources[j].Resource
+		}
+		return resources[i].Group < resources[j].Group
+	})
+	return resources, nil
+}
+
+// List returns the objects of the given resource matching the label and field selectors of opts.
+// For namespaced resources an empty namespace lists the objects of all namespaces, while
+// the namespace is ignored for cluster-scoped ones.
+func (r *Reader) List(gvr schema.GroupVersionResource, namespace string, opts metav1.ListOptions) ([]unstructured.Unstructured, error) {
+	var items []unstructured.Unstructured
+	var err error
+	switch {
+	case isNamespaceResource(gvr):
+		items, err = r.namespaceObjects(namespace)
+	case r.clusterScoped(gvr):
+		items, err = r.readClusterScoped(gvr)
+	default:
+		err = r.VisitNamespaced(gvr, namespace, func(_ string, nsItems []unstructured.Unstructured) error {
+			items = append(items, nsItems...)
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return FilterObjects(items, opts)
+}
+
+// Count returns the number of objects of the given resource, in all namespaces for namespaced
+// resources. The objects held by the index are counted without being decoded, only the
+// resources the index does not hold being read.
+func (r *Reader) Count(gvr schema.GroupVersionResource) (int, error) {
+	group := groupDir(gvr)
+	if !isNamespaceResource(gvr) && r.clusterScoped(gvr) {
+		if n, ok := r.index.Count("", group, gvr.Resource); ok {
+			return n, nil
+		}
+		items, err := r.readClusterScoped(gvr)
+		return len(items), err
+	}
+	namespaces, err := r.Namespaces()
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if r.index == nil {
+		// without an index, the objects of the namespaces are read concurrently
+		count := 0
+		if isNamespaceResource(gvr) {
+			items, err := r.namespaceObjects("")
+			return len(items), err
+		}
+		err := r.VisitNamespaced(gvr, "", func(_ string, items []unstructured.Unstructured) error {
+			count += len(items)
+			return nil
+		})
+		return count, err
+	}
+	resource := gvr.Resource
+	if isNamespaceResource(gvr) {
+		// the namespace objects, also listed as projects, are indexed in each namespace
+		group, resource = "core", "namespaces"
+	}
+	count := 0
+	for _, namespace := range namespaces {
+		if n, ok := r.index.Count(namespace, group, resource); ok {
+			count += n
+			continue
+		}
+		var items []unstructured.Unstructured
+		if isNamespaceResource(gvr) {
+			items, err = r.namespaceObjects(namespace)
+		} else {
+			items, err = r.readNamespaced(gvr, namespace)
+		}
+		if err != nil {
+			return 0, err
+		}
+		count += len(items)
+	}
+	return count, nil
+}
+
+// Get returns a single object, whose namespace is ignored for cluster-scoped resources.
+// A NotFound API error is returned when the object is not part of the must-gather.
+func (r *Reader) Get(gvr schema.GroupVersionResource, namespace string, name string) (*unstructured.Unstructured, error) {
+	notFound := apierrors.NewNotFound(schema.GroupResource{Group: apiGroup(gvr), Resource: gvr.Resource}, name)
+	if isNamespaceResource(gvr) {
+		if name == "" {
+			return nil, notFound
+		}
+		item, err := r.readNamespaceObject(name)
+		if os.IsNotExist(err) {
+			return nil, notFound
+		}
+		return item, err
+	}
+	clusterScoped := r.clusterScoped(gvr)
+	if clusterScoped {
+		namespace = ""
+	}
+	if item, ok := r.index.Get(namespace, groupDir(gvr), gvr.Resource, name); ok {
+		if item.Object == nil {
+			return nil, notFound
+		}
+		return &item, nil
+	}
+	var items []unstructured.Unstructured
+	var err error
+	if clusterScoped {
+		items, err = r.readClusterScoped(gvr)
+	} else {
+		items, err = r.readNamespaced(gvr, namespace)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		if items[i].GetName() == name {
+			return &items[i], nil
+		}
+	}
+	return nil, notFound
+}
+
+// VisitNamespaced calls visit with the objects of the given namespaced resource stored in
+// each namespace, one namespace at a time and in namespace order, all namespaces if namespace
+// is empty. Namespaces are read concurrently, visiting stops at the first error.
+func (r *Reader) VisitNamespaced(gvr schema.GroupVersionResource, namespace string, visit func(namespace string, items []unstructured.Unstructured) error) error {
+	namespaces := []string{namespace}
+	if namespace == "" {
+		var err error
+		if namespaces, err = r.Namespaces(); err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+	}
+	type result struct {
+		items []unstructured.Unstructured
+		err   error
+	}
+	results := make([]chan result, len(namespaces))
+	for i := range results {
+		results[i] = make(chan result, 1)
+	}
+	jobs := make(chan int)
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	defer func() {
+		// on errors, stop feeding the workers and wait for them to return
+		close(stop)
+		wg.Wait()
+	}()
+	go func() {
+		defer close(jobs)
+		for i := range namespaces {
+			select {
+			case jobs <- i:
+			case <-stop:
+				return
+			}
+		}
+	}()
+	for w := 0; w < min(r.parallelism, len(namespaces)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				items, err := r.readNamespaced(gvr, namespaces[i])
+				results[i] <- result{items: items, err: err}
+			}
+		}()
+	}
+	for i, namespace := range namespaces {
+		res := <-results[i]
+		if res.err != nil {
+			return res.err
+		}
+		if err := visit(namespace, res.items); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readNamespaced reads the objects of a resource stored in a namespace, either as a single
+// "<resource>.yaml" list or as a "<resource>" directory with one file per object.
+func (r *Reader) readNamespaced(gvr schema.GroupVersionResource, namespace string) ([]unstructured.Unstructured, error) {
+	group := groupDir(gvr)
+	if items, ok := r.index.Namespaced(namespace, group, gvr.Resource); ok {
+		return items, nil
+	}
+	namespaceDir := filepath.Join(r.root, "namespaces", namespace)
+	isPods := group == "core" && gvr.Resource == "pods"
+	listPath := filepath.Join(namespaceDir, group, gvr.Resource+".yaml")
+	data, err := os.ReadFile(listPath)
+	if err == nil {
+		items, err := readList(listPath, data)
+		if isPods && err == nil && len(items) == 0 {
+			// core/pods.yaml may be empty due to unknown reasons when the must-gather is collected,
+			// in such cases the pods are read from the pods directory
+			return readPodsDir(filepath.Join(namespaceDir, "pods"))
+		}
+		return items, err
+	}
+	resourceDir := filepath.Join(namespaceDir, group, gvr.Resource)
+	if _, err := os.Stat(resourceDir); err == nil {
+		return readDir(resourceDir, fmt.Sprintf("/namespaces/%s/%s/%s", namespace, group, gvr.Resource))
+	}
+	if isPods {
+		return readPodsDir(filepath.Join(namespaceDir, "pods"))
+	}
+	return nil, nil
+}
+
+// readClusterScoped reads the objects of a cluster-scoped resource, either as a single
+// "<resource>.yaml" list or as a "<resource>" directory with one file per object.
+func (r *Reader) readClusterScoped(gvr schema.GroupVersionResource) ([]unstructured.Unstructured, error) {
+	group := groupDir(gvr)
+	if items, ok := r.index.ClusterScoped(group, gvr.Resource); ok {
+		return items, nil
+	}
+	listPath := filepath.Join(r.root, "cluster-scoped-resources", group, gvr.Resource+".yaml")
+	data, err := os.ReadFile(listPath)
+	if err == nil {
+		return readList(listPath, data)
+	}
+	resourceDir := filepath.Join(r.root, "cluster-scoped-resources", group, gvr.Resource)
+	entries, err := readDirForResources(resourceDir)
+	if err != nil {
+		klog.V(3).ErrorS(err, "Failed to read resources:")
+	}
+	var items []unstructured.Unstructured
+	for _, e := range entries {
+		path := filepath.Join(resourceDir, e.Name())
+		item, err := readObject(path)
+		if err != nil {
+			return nil, err
+		}
+		if item.IsList() {
+			return nil, fmt.Errorf("file %q contains a \"List\" objectKind, while it should contain a single resource", path)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// namespaceObjects returns the namespace objects stored in "namespaces/<namespace>/<namespace>.yaml",
+// only the given one if namespace is not empty.
+func (r *Reader) namespaceObjects(namespace string) ([]unstructured.Unstructured, error) {
+	var namespaces []string
+	if namespace != "" {
+		namespaces = []string{namespace}
+	} else {
+		entries, _ := os.ReadDir(filepath.Join(r.root, "namespaces"))
+		for _, e := range entries {
+			namespaces = append(namespaces, e.Name())
+		}
+	}
+	var items []unstructured.Unstructured
+	for _, ns := range namespaces {
+		item, err := r.readNamespaceObject(ns)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		items = append(items, *item)
+	}
+	return items, nil
+}
+
+func (r *Reader) readNamespaceObject(namespace string) (*unstructured.Unstructured, error) {
+	if items, ok := r.index.Namespaced(namespace, "core", "namespaces"); ok && len(items) == 1 {
+		return &items[0], nil
+	}
+	path := filepath.Join(r.root, "namespaces", namespace, namespace+".yaml")
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	item, err := readObject(path)
+	if err != nil {
+		return nil, err
+	}
+	if item.Object == nil {
+		return nil, fmt.Errorf("empty resource file %s", path)
+	}
+	return &item, nil
+}
+
+// clusterScoped reports whether the resource is stored in the cluster-scoped-resources directory.
+func (r *Reader) clusterScoped(gvr schema.GroupVersionResource) bool {
+	base := filepath.Join(r.root, "cluster-scoped-resources", groupDir(gvr), gvr.Resource)
+	if _, err := os.Stat(base + ".yaml"); err == nil {
+		return true
+	}
+	_, err := os.Stat(base)
+	return err == nil
+}
+
+func isNamespaceResource(gvr schema.GroupVersionResource) bool {
+	return (groupDir(gvr) == "core" && gvr.Resource == "namespaces") ||
+		(gvr.Group == "project.openshift.io" && gvr.Resource == "projects")
+}
+
+// groupDir returns the name of the must-gather directory holding the resources of a group.
+func groupDir(gvr schema.GroupVersionResource) string {
+	if gvr.Group == "" {
+		return "core"
+	}
+	return gvr.Group
+}
+
+func apiGroup(gvr schema.GroupVersionResource) string {
+	if gvr.Group == "core" {
+		return ""
+	}
+	return gvr.Group
+}
+
+func readList(path string, data []byte) ([]unstructured.Unstructured, error) {
+	list := struct {
+		Items []unstructured.Unstructured `json:"items"`
+	}{}
+	if err := yaml.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("error unmarshaling %s: %w", path, err)
+	}
+	return list.Items, nil
+}
+
+// readDir reads a directory holding one file per object; relPath is used to report
+// unexpected subdirectories.
+func readDir(dir string, relPath string) ([]unstructured.Unstructured, error) {
+	entries, err := readDirForResources(dir)
+	if err != nil {
+		klog.V(3).ErrorS(err, "Failed to read resources:")
+	}
+	var items []unstructured.Unstructured
+	for _, e := range entries {
+		if e.IsDir() {
+			// the must-gather is malformed, the other objects of the resource are still read
+			klog.V(1).InfoS("Skipping a directory where yaml files are expected, the must-gather structure is invalid", "path", relPath, "directory", e.Name())
+			continue
+		}
+		item, err := readObject(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func readPodsDir(podsDir string) ([]unstructured.Unstructured, error) {
+	pods, err := readDirForResources(podsDir)
+	if err != nil && !os.IsNotExist(err) {
+		klog.V(3).ErrorS(err, "Failed to read resources:")
+	}
+	var items []unstructured.Unstructured
+	for _, pod := range pods {
+		if !pod.IsDir() {
+			continue
+		}
+		item, err := readObject(filepath.Join(podsDir, pod.Name(), pod.Name()+".yaml"))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		if item.Object != nil {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func readObject(path string) (unstructured.Unstructured, error) {
+	item := unstructured.Unstructured{}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return item, err
+		}
+		return item, fmt.Errorf("error reading %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(data, &item); err != nil {
+		return item, fmt.Errorf("error unmarshaling %s: %w", path, err)
+	}
+	return item, nil
+}
+
+// readDirForResources returns the entries of dir which may hold resources: non empty yaml
+// files and directories, whose names are valid kubernetes names.
+func readDirForResources(dir string) ([]os.DirEntry, error) {
+	klog.V(5).Info("INFO ", fmt.Sprintf("opening '%s'\n", dir))
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	resources := make([]os.DirEntry, 0, len(entries))
+	for _, e := range entries {
+		if len(validation.IsDNS1123Subdomain(e.Name())) != 0 {
+			continue
+		}
+		if filepath.Ext(e.Name()) != ".yaml" && !e.IsDir() {
+			continue
+		}
+		if info, err := e.Info(); err != nil || info.Size() == 0 {
+			continue
+		}
+		resources = append(resources, e)
+	}
+	return resources, nil
+}
@@ -0,0 +1,406 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package mustgather
+
+import (
+	"io"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gmeghnag/omc/internal/testutil"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func newReaderFixture(t *testing.T) string {
+	return testutil.MustGather(t, map[string]string{
+		"namespaces/ns1/ns1.yaml": testutil.Namespace("ns1"),
+		"namespaces/ns2/ns2.yaml": testutil.Namespace("ns2"),
+		"namespaces/ns1/core/configmaps.yaml": `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: cm1
+    namespace: ns1
+    labels:
+      app: web
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: cm2
+    namespace: ns1
+`,
+		"namespaces/ns2/core/configmaps.yaml": `apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: cm3
+    namespace: ns2
+    labels:
+      app: web
+`,
+		"namespaces/ns1/core/pods.yaml": "",
+		"namespaces/ns1/pods/web-0/web-0.yaml": `apiVersion: v1
+kind: Pod
+metadata:
+  name: web-0
+  namespace: ns1
+spec:
+  containers:
+  - name: web
+  - name: proxy
+  initContainers:
+  - name: init
+`,
+		"namespaces/ns1/pods/web-0/web/web/logs/current.log":                   "current\n",
+		"namespaces/ns1/pods/web-0/web/web/logs/previous.log":                  "previous\n",
+		"namespaces/ns1/pods/web-0/web/web/logs/rotated/0.log.20240101-000000": "rotated-0\n",
+		"namespaces/ns1/pods/web-0/web/web/logs/rotated/0.log.20240102-000000": "rotated-1\n",
+		"namespaces/ns1/route.openshift.io/routes/web.yaml": `apiVersion: route.openshift.io/v1
+kind: Route
+metadata:
+  name: web
+  namespace: ns1
+`,
+		"namespaces/ns1/core/events.yaml": `apiVersion: v1
+kind: EventList
+items:
+- apiVersion: v1
+  kind: Event
+  metadata:
+    name: web-0.1
+    namespace: ns1
+  reason: Started
+`,
+		"namespaces/ns2/core/events.yaml": `apiVersion: v1
+kind: EventList
+items:
+- apiVersion: v1
+  kind: Event
+  metadata:
+    name: cm3.1
+    namespace: ns2
+  reason: Updated
+`,
+		"cluster-scoped-resources/core/nodes/master-0.yaml": `apiVersion: v1
+kind: Node
+metadata:
+  name: master-0
+`,
+	})
+}
+
+func names(items []unstructured.Unstructured) []string {
+	var names []string
+	for _, item := range items {
+		names = append(names, item.GetName())
+	}
+	return names
+}
+
+func TestReaderList(t *testing.T) {
+	r := NewReader(newReaderFixture(t), WithParallelism(1))
+	tests := []struct {
+		name      string
+		gvr       schema.GroupVersionResource
+		namespace string
+		opts      metav1.ListOptions
+		want      []string
+	}{
+		{name: "list file", gvr: schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, namespace: "ns1", want: []string{"cm1", "cm2"}},
+		{name: "all namespaces", gvr: schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, want: []string{"cm1", "cm2", "cm3"}},
+		{name: "label selector", gvr: schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, opts: metav1.ListOptions{LabelSelector: "app=web"}, want: []string{"cm1", "cm3"}},
+		{name: "field selector", gvr: schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, opts: metav1.ListOptions{FieldSelector: "metadata.namespace=ns2"}, want: []string{"cm3"}},
+		{name: "empty pods list falls back to the pods directory", gvr: schema.GroupVersionResource{Version: "v1", Resource: "pods"}, namespace: "ns1", want: []string{"web-0"}},
+		{name: "one file per object", gvr: schema.GroupVersionResource{Group: "route.openshift.io", Version: "v1", Resource: "routes"}, namespace: "ns1", want: []string{"web"}},
+		{name: "cluster scoped", gvr: schema.GroupVersionResource{Group: "core", Resource: "nodes"}, namespace: "ns1", want: []string{"master-0"}},
+		{name: "namespaces", gvr: schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}, want: []string{"ns1", "ns2"}},
+		{name: "missing resource", gvr: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, namespace: "ns1"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			items, err := r.List(tc.gvr, tc.namespace, tc.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := names(items); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+
+	t.Run("invalid label selector", func(t *testing.T) {
+		if _, err := r.List(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, "", metav1.ListOptions{LabelSelector: "app in (web"}); err == nil {
+			t.Errorf("expected an invalid label selector error")
+		}
+	})
+}
+
+func TestReaderGet(t *testing.T) {
+	r := NewReader(newReaderFixture(t))
+	item, err := r.Get(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, "ns2", "cm3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.GetName() != "cm3" {
+		t.Errorf("expected configmap cm3, got %s", item.GetName())
+	}
+	if _, err := r.Get(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, "ns2", "cm1"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a NotFound error, got %v", err)
+	}
+	if _, err := r.Get(schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}, "", "ns3"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a NotFound error, got %v", err)
+	}
+}
+
+func TestReaderEvents(t *testing.T) {
+	r := NewReader(newReaderFixture(t))
+	events, err := r.Events("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, e := range events.Items {
+		got = append(got, e.Name)
+	}
+	if want := []string{"web-0.1", "cm3.1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if events, _ := r.Events("ns2"); len(events.Items) != 1 {
+		t.Errorf("expected a single event in ns2, got %d", len(events.Items))
+	}
+}
+
+func TestReaderPodLogs(t *testing.T) {
+	r := NewReader(newReaderFixture(t))
+	tests := []struct {
+		name    string
+		opts    PodLogOptions
+		want    []string
+		wantErr string
+	}{
+		{name: "current", opts: PodLogOptions{Container: "web"}, want: []string{"current\n"}},
+		{name: "previous", opts: PodLogOptions{Container: "web", Previous: true}, want: []string{"previous\n"}},
+		{name: "rotated", opts: PodLogOptions{Container: "web", Rotated: true}, want: []string{"rotated-0\nrotated-1\n"}},
+		{name: "all containers", opts: PodLogOptions{AllContainers: true}, want: []string{"current\n", ""}},
+		{name: "init container", opts: PodLogOptions{Container: "init"}, want: []string{""}},
+		{name: "default container", opts: PodLogOptions{DefaultContainer: true}, want: []string{"current\n"}},
+		{name: "all logs", opts: PodLogOptions{Container: "web", AllLogs: true}, want: []string{"rotated-0\nrotated-1\nprevious\ncurrent\n"}},
+		{name: "invalid container", opts: PodLogOptions{Container: "db"}, wantErr: "container db is not valid for pod web-0"},
+		{name: "missing container", wantErr: "a container name must be specified for pod web-0, choose one of: [web proxy init]"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := r.PodLogs("ns1", "web-0", tc.opts)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, l := range logs {
+				rc, err := l.Open()
+				if err != nil {
+					t.Fatal(err)
+				}
+				data, err := io.ReadAll(rc)
+				rc.Close()
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, string(data))
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+
+	t.Run("missing pod", func(t *testing.T) {
+		if _, err := r.PodLogs("ns1", "web-1", PodLogOptions{}); !apierrors.IsNotFound(err) {
+			t.Errorf("expected a NotFound error, got %v", err)
+		}
+	})
+}
+
+func TestReaderResources(t *testing.T) {
+	r := NewReader(newReaderFixture(t))
+	tests := []struct {
+		name      string
+		namespace string
+		want      []schema.GroupResource
+	}{
+		{name: "namespaced", namespace: "ns1", want: []schema.GroupResource{
+			{Resource: "configmaps"},
+			{Resource: "events"},
+			{Resource: "pods"},
+			{Group: "route.openshift.io", Resource: "routes"},
+		}},
+		{name: "cluster scoped", want: []schema.GroupResource{{Resource: "nodes"}}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := r.Resources(tc.namespace)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestReaderWorkloadPods(t *testing.T) {
+	root := newReaderFixture(t)
+	testutil.WriteFiles(t, root, map[string]string{
+		"namespaces/ns2/apps/deployments.yaml": `apiVersion: apps/v1
+kind: DeploymentList
+items:
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: api
+    namespace: ns2
+  spec:
+    selector:
+      matchLabels:
+        app: api
+`,
+		"namespaces/ns2/apps/daemonsets.yaml": `apiVersion: apps/v1
+kind: DaemonSetList
+items:
+- apiVersion: apps/v1
+  kind: DaemonSet
+  metadata:
+    name: agent
+    namespace: ns2
+  spec:
+    selector:
+      matchExpressions:
+      - key: app
+        operator: In
+        values: [agent]
+`,
+		"namespaces/ns2/apps/replicasets.yaml": `apiVersion: apps/v1
+kind: ReplicaSetList
+items:
+- apiVersion: apps/v1
+  kind: ReplicaSet
+  metadata:
+    name: api-1
+    namespace: ns2
+    labels:
+      app: api
+    ownerReferences:
+    - kind: Deployment
+      name: api
+- apiVersion: apps/v1
+  kind: ReplicaSet
+  metadata:
+    name: api-orphan
+    namespace: ns2
+    labels:
+      app: api
+`,
+		"namespaces/ns2/core/pods.yaml": `apiVersion: v1
+kind: PodList
+items:
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: api-1-b
+    namespace: ns2
+    labels:
+      app: api
+    ownerReferences:
+    - kind: ReplicaSet
+      name: api-1
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: api-1-a
+    namespace: ns2
+    labels:
+      app: api
+    ownerReferences:
+    - kind: ReplicaSet
+      name: api-1
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: api-orphan-a
+    namespace: ns2
+    labels:
+      app: api
+    ownerReferences:
+    - kind: ReplicaSet
+      name: api-orphan
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: agent-x
+    namespace: ns2
+    labels:
+      app: agent
+    ownerReferences:
+    - kind: DaemonSet
+      name: agent
+`,
+	})
+	r := NewReader(root)
+	tests := []struct {
+		name string
+		gvr  schema.GroupVersionResource
+		want []string
+	}{
+		{name: "deployment through its replicasets", gvr: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}, want: []string{"api-1-a", "api-1-b"}},
+		{name: "replicaset", gvr: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}, want: []string{"api-orphan-a"}},
+		{name: "daemonset with match expressions", gvr: schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "daemonsets"}, want: []string{"agent-x"}},
+	}
+	workloads := map[string]string{"deployments": "api", "replicasets": "api-orphan", "daemonsets": "agent"}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pods, err := r.WorkloadPods(tc.gvr, "ns2", workloads[tc.gvr.Resource])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := names(pods); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+
+	t.Run("missing workload", func(t *testing.T) {
+		if _, err := r.WorkloadPods(schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "statefulsets"}, "ns2", "db"); !apierrors.IsNotFound(err) {
+			t.Errorf("expected a NotFound error, got %v", err)
+		}
+	})
+}
+
+func TestRotatedLogFiles(t *testing.T) {
+	dir := t.TempDir()
+	// the container restarted between its rotations, the name prefixes do not sort by time
+	for _, name := range []string{"1.log.20231102-061208.gz", "0.log.20231101-000000.gz", "0.log.20231102-000000", "2.log"} {
+		testutil.WriteFiles(t, dir, map[string]string{
+			filepath.Join(RotatedLogDir, name): "",
+		})
+	}
+	want := []string{"rotated/2.log", "rotated/0.log.20231101-000000.gz", "rotated/0.log.20231102-000000", "rotated/1.log.20231102-061208.gz"}
+	if got := RotatedLogFiles(dir); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mustgather
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// FilterObjects returns the objects matching the label and field selectors of opts.
+func FilterObjects(items []unstructured.Unstructured, opts metav1.ListOptions) ([]unstructured.Unstructured, error) {
+	if opts.LabelSelector == "" && opts.FieldSelector == "" {
+		return items, nil
+	}
+	labelSelector, err := ParseLabelSelector(opts.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector %q: %w", opts.LabelSelector, err)
+	}
+	fieldSelector, err := fields.ParseSelector(opts.FieldSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid field selector %q: %w", opts.FieldSelector, err)
+	}
+	var filtered []unstructured.Unstructured
+	for _, item := range items {
+		if labelSelector.Matches(labels.Set(item.GetLabels())) && MatchFieldSelector(item.Object, fieldSelector) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered, nil
+}
+
+// ParseLabelSelector parses a label selector using the full kubernetes syntax: equality-based
+// ('=', '==', '!=') and set-based ('in', 'notin', 'key', '!key') requirements. When the selector
+// is invalid the returned error names the first offending term.
+func ParseLabelSelector(selector string) (labels.Selector, error) {
+	labelSelector, err := labels.Parse(selector)
+	if err == nil {
+		return labelSelector, nil
+	}
+	for _, term := range splitSelectorTerms(selector) {
+		if strings.TrimSpace(term) == "" {
+			return nil, fmt.Errorf("empty term in %q", selector)
+		}
+		if _, termErr := labels.Parse(term); termErr != nil {
+			return nil, fmt.Errorf("term %q: %w", strings.TrimSpace(term), termErr)
+		}
+	}
+	return nil, err
+}
+
+// splitSelectorTerms splits a selector on the commas which are not part of a set of values.
+func splitSelectorTerms(selector string) []string {
+	var terms []string
+	depth, start := 0, 0
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(terms, selector[start:])
+}
+
+// MatchFieldSelector reports whether the object satisfies a field selector, with the same
+// '=', '==' and '!=' semantics as kubectl. Unlike the API server, any field path of any
+// kind can be used; missing fields are treated as empty values.
+func MatchFieldSelector(object map[string]interface{}, selector fields.Selector) bool {
+	set := fields.Set{}
+	for _, r := range selector.Requirements() {
+		value, found, _ := unstructured.NestedFieldNoCopy(object, strings.Split(r.Field, ".")...)
+		if !found || value == nil {
+			continue
+		}
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			// only scalar values can be compared
+		default:
+			set[r.Field] = fmt.Sprint(value)
+		}
+	}
+	return selector.Matches(set)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package mustgather
+
+import (
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	podsResource        = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	replicaSetsResource = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "replicasets"}
+)
+
+// WorkloadPods returns the pods of a workload (e.g. a deployment, a daemonset, a statefulset or
+// a job) sorted by name: the pods matching its selector which it owns, or which are owned by a
+// replicaset it owns, as deployments do. A NotFound API error is returned when the workload is
+// not part of the must-gather.
+func (r *Reader) WorkloadPods(gvr schema.GroupVersionResource, namespace string, name string) ([]unstructured.Unstructured, error) {
+	workload, err := r.Get(gvr, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	selector, err := workloadSelector(workload)
+	if err != nil {
+		return nil, err
+	}
+	owners := map[ownerKey]bool{keyOf(workload): true}
+	if gvr.Resource == "deployments" {
+		replicaSets, err := r.List(replicaSetsResource, namespace, metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return nil, err
+		}
+		for i := range replicaSets {
+			if ownedBy(&replicaSets[i], owners) {
+				owners[keyOf(&replicaSets[i])] = true
+			}
+		}
+	}
+	pods, err := r.List(podsResource, namespace, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	var owned []unstructured.Unstructured
+	for i := range pods {
+		if ownedBy(&pods[i], owners) {
+			owned = append(owned, pods[i])
+		}
+	}
+	sort.SliceStable(owned, func(i, j int) bool {
+		return owned[i].GetName() < owned[j].GetName()
+	})
+	return owned, nil
+}
+
+// workloadSelector returns the label selector of a workload, as a string.
+func workloadSelector(workload *unstructured.Unstructured) (string, error) {
+	spec, _, _ := unstructured.NestedMap(workload.Object, "spec", "selector")
+	var labelSelector metav1.LabelSelector
+	if matchLabels, _, _ := unstructured.NestedStringMap(spec, "matchLabels"); matchLabels != nil {
+		labelSelector.MatchLabels = matchLabels
+	}
+	expressions, _, _ := unstructured.NestedSlice(spec, "matchExpressions")
+	for _, e := range expressions {
+		expression, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key, _, _ := unstructured.NestedString(expression, "key")
+		operator, _, _ := unstructured.NestedString(expression, "operator")
+		values, _, _ := unstructured.NestedStringSlice(expression, "values")
+		labelSelector.MatchExpressions = append(labelSelector.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key: key, Operator: metav1.LabelSelectorOperator(operator), Values: values,
+		})
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+	if err != nil {
+		return "", fmt.Errorf("invalid selector of %s %s: %w", workload.GetKind(), workload.GetName(), err)
+	}
+	return selector.String(), nil
+}
+
+// ownerKey identifies an owner by kind and name, as the uid may not be stored in must-gathers.
+type ownerKey struct {
+	kind, name string
+}
+
+func keyOf(obj *unstructured.Unstructured) ownerKey {
+	return ownerKey{kind: obj.GetKind(), name: obj.GetName()}
+}
+
+// ownedBy returns whether one of the owners of an object is part of owners.
+func ownedBy(obj *unstructured.Unstructured, owners map[ownerKey]bool) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if owners[ownerKey{kind: ref.Kind, name: ref.Name}] {
+			return true
+		}
+	}
+	return false
+}
@@ -84,7 +84,7 @@ func InternalResourceTable(runtimeObject runtime.Object, unstruct *unstructured.
 	}
 	if table.ColumnDefinitions[0].Name == "Name" {
 		if vars.ShowKind {
-			table.Rows[0].Cells[0] = resourceKind + "/" + unstruct.GetName()
+			table.Rows[0].Cells[0] = kindName(unstruct)
 		} else {
 			table.Rows[0].Cells[0] = unstruct.GetName()
 		}
@@ -117,16 +117,11 @@ func InternalResourceTable(runtimeObject runtime.Object, unstruct *unstructured.
 		table.ColumnDefinitions = append([]metav1.TableColumnDefinition{{Format: "string", Name: "Namespace"}}, table.ColumnDefinitions...)
 		table.Rows[0].Cells = append([]interface{}{unstruct.GetNamespace()}, table.Rows[0].Cells...)
 	}
-	if vars.ShowLabelsBoolVar {
-		table.ColumnDefinitions = append(table.ColumnDefinitions, metav1.TableColumnDefinition{Format: "string", Name: "Labels"})
-		labels := helpers.ExtractLabels(unstruct.GetLabels())
-		table.Rows[0].Cells = append(table.Rows[0].Cells, labels)
-	}
+	appendLabelColumns(table, unstruct.GetLabels())
 	return table, err
 }
 
 func InternalUnstructuredApiResource(unstruct unstructured.Unstructured) (*metav1.Table, error) {
-	resourceKind := strings.ToLower(unstruct.GetKind())
 	table := &metav1.Table{}
 	if vars.ShowNamespace && unstruct.GetNamespace() != "" {
 		table.ColumnDefinitions = []metav1.TableColumnDefinition{
@@ -134,8 +129,8 @@ func InternalUnstructuredApiResource(unstruct unstructured.Unstructured) (*metav
 			{Name: "Name", Type: "string", Format: "string"},
 			{Name: "Created At", Type: "date"},
 		}
-		if vars.ShowKind || vars.Namespace == "" {
-			table.Rows = []metav1.TableRow{{Cells: []interface{}{unstruct.GetNamespace(), resourceKind + "." + strings.Split(unstruct.GetAPIVersion(), "/")[0] + "/" + unstruct.GetName(), unstruct.GetCreationTimestamp().Time.UTC().Format("2006-01-02T15:04:05")}}}
+		if vars.ShowKind {
+			table.Rows = []metav1.TableRow{{Cells: []interface{}{unstruct.GetNamespace(), kindName(&unstruct), unstruct.GetCreationTimestamp().Time.UTC().Format("2006-01-02T15:04:05")}}}
 		} else {
 			table.Rows = []metav1.TableRow{{Cells: []interface{}{unstruct.GetNamespace(), unstruct.GetName(), unstruct.GetCreationTimestamp().Time.UTC().Format("2006-01-02T15:04:05")}}}
 		}
@@ -145,14 +140,15 @@ func InternalUnstructuredApiResource(unstruct unstructured.Unstructured) (*metav
 			{Name: "Name", Type: "string", Format: "name"},
 			{Name: "Created At", Type: "date"},
 		}
-		if vars.ShowKind || vars.Namespace == "" {
-			table.Rows = []metav1.TableRow{{Cells: []interface{}{resourceKind + "." + strings.Split(unstruct.GetAPIVersion(), "/")[0] + "/" + unstruct.GetName(), unstruct.GetCreationTimestamp().Time.UTC().Format("2006-01-02T15:04:05")}}}
+		if vars.ShowKind {
+			table.Rows = []metav1.TableRow{{Cells: []interface{}{kindName(&unstruct), unstruct.GetCreationTimestamp().Time.UTC().Format("2006-01-02T15:04:05")}}}
 
 		} else {
 			table.Rows = []metav1.TableRow{{Cells: []interface{}{unstruct.GetName(), unstruct.GetCreationTimestamp().Time.UTC().Format("2006-01-02T15:04:05")}}}
 		}
 
 	}
+	appendLabelColumns(table, unstruct.GetLabels())
 	return table, nil
 }
 
@@ -169,10 +165,10 @@ func GenerateCustomResourceTable(unstruct unstructured.Unstructured) (*metav1.Ta
 	if vars.ShowKind == true {
 		if vars.ShowNamespace && unstruct.GetNamespace() != "" {
 			table.ColumnDefinitions = []metav1.TableColumnDefinition{{Name: "Namespace", Format: "string"}, {Name: "Name", Format: "name"}}
-			cells = []interface{}{unstruct.GetNamespace(), resourceKind + "/" + unstruct.GetName()}
+			cells = []interface{}{unstruct.GetNamespace(), kindName(&unstruct)}
 		} else {
 			table.ColumnDefinitions = []metav1.TableColumnDefinition{{Name: "Name", Format: "name"}}
-			cells = []interface{}{resourceKind + "/" + unstruct.GetName()}
+			cells = []interface{}{kindName(&unstruct)}
 		}
 	} else {
 		if vars.ShowNamespace && unstruct.GetNamespace() != "" {
@@ -211,11 +207,32 @@ func GenerateCustomResourceTable(unstruct unstructured.Unstructured) (*metav1.Ta
 		}
 	}
 	table.Rows = []metav1.TableRow{{Cells: cells}}
+	appendLabelColumns(table, unstruct.GetLabels())
+
+	return table, nil
+}
+
+// kindName returns the name of an object prefixed by its kind and, unless it is a core kind, its
+// group, e.g. "deployment.apps/web", as kubectl prints objects of several kinds.
+func kindName(unstruct *unstructured.Unstructured) string {
+	gk := unstruct.GroupVersionKind().GroupKind()
+	kind := strings.ToLower(gk.Kind)
+	if gk.Group != "" {
+		kind += "." + gk.Group
+	}
+	return kind + "/" + unstruct.GetName()
+}
+
+// appendLabelColumns appends a column for each label of -L/--label-columns, named after the last
+// segment of the label key as kubectl does, and the LABELS column of --show-labels.
+func appendLabelColumns(table *metav1.Table, labels map[string]string) {
+	for _, key := range vars.LabelColumns {
+		segments := strings.Split(key, "/")
+		table.ColumnDefinitions = append(table.ColumnDefinitions, metav1.TableColumnDefinition{Format: "string", Name: strings.ToUpper(segments[len(segments)-1])})
+		table.Rows[0].Cells = append(table.Rows[0].Cells, labels[key])
+	}
 	if vars.ShowLabelsBoolVar {
 		table.ColumnDefinitions = append(table.ColumnDefinitions, metav1.TableColumnDefinition{Format: "string", Name: "Labels"})
-		labels := helpers.ExtractLabels(unstruct.GetLabels())
-		table.Rows[0].Cells = append(table.Rows[0].Cells, labels)
+		table.Rows[0].Cells = append(table.Rows[0].Cells, helpers.ExtractLabels(labels))
 	}
-
-	return table, nil
 }
@@ -0,0 +1,242 @@
+/*
+Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tableprinter prints the tables of the omc commands, either aligned for humans, as
+// comma or tab separated values for spreadsheets or as markdown or HTML for reports, selected
+// by their -o flag.
+package tableprinter
+
+import (
+	"encoding/csv"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/olekukonko/tablewriter"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// CSV and TSV are the -o values printing comma and tab separated values.
+	CSV = "csv"
+	TSV = "tsv"
+	// Markdown and HTML are the -o values printing tables to paste in reports.
+	Markdown = "markdown"
+	HTML     = "html"
+)
+
+// IsDelimited returns whether an output format prints tables as comma or tab separated values.
+func IsDelimited(output string) bool {
+	return output == CSV || output == TSV
+}
+
+// IsDocument returns whether an output format prints tables as markdown or HTML.
+func IsDocument(output string) bool {
+	return output == Markdown || output == HTML
+}
+
+// Options set how a table is printed.
+type Options struct {
+	// Output is the -o flag of the command: csv and tsv print delimited values, markdown and html
+	// documents, any other value an aligned table.
+	Output string
+	// NoHeaders is ignored by markdown, whose tables require a header row.
+	NoHeaders bool
+	// Bordered draws the aligned table with ASCII borders, as etcdctl does.
+	Bordered bool
+	// Indent prefixes each line of the aligned table.
+	Indent string
+	// MinWidth is the minimal width of the columns of the aligned table, padding included.
+	MinWidth int
+}
+
+// Table is a table of cells, printed by Print.
+type Table struct {
+	opts    Options
+	headers []string
+	rows    [][]string
+}
+
+// New returns an empty table with the given headers, printed as they are given.
+func New(opts Options, headers ...string) *Table {
+	return &Table{opts: opts, headers: headers}
+}
+
+// FromMetaTable returns a table of the columns of a metav1.Table, only those of priority 0
+// unless wide, with their names upper-cased and nil cells left empty, as kubectl prints them.
+func FromMetaTable(opts Options, table *metav1.Table, wide bool) *Table {
+	t := New(opts)
+	var columns []int
+	for i, column := range table.ColumnDefinitions {
+		if !wide && column.Priority != 0 {
+			continue
+		}
+		columns = append(columns, i)
+		t.headers = append(t.headers, strings.ToUpper(column.Name))
+	}
+	for _, row := range table.Rows {
+		cells := make([]string, len(columns))
+		for j, i := range columns {
+			if i < len(row.Cells) && row.Cells[i] != nil {
+				cells[j] = fmt.Sprint(row.Cells[i])
+			}
+		}
+		t.Append(cells...)
+	}
+	return t
+}
+
+// Concat returns a single table of the rows of tables with different columns: a first column
+// named header holds the key of the table of each row, followed by the columns of all the
+// tables in the order they are first seen, the cells of the columns a table does not have being
+// left empty.
+func Concat(opts Options, header string, keys []string, tables []*Table) *Table {
+	t := New(opts, header)
+	index := make(map[string]int)
+	for _, table := range tables {
+		for _, h := range table.headers {
+			if _, ok := index[h]; !ok {
+				index[h] = len(t.headers)
+				t.headers = append(t.headers, h)
+			}
+		}
+	}
+	for i, table := range tables {
+		for _, row := range table.rows {
+			cells := make([]string, len(t.headers))
+			cells[0] = keys[i]
+			for j, cell := range row {
+				if j < len(table.headers) {
+					cells[index[table.headers[j]]] = cell
+				}
+			}
+			t.Append(cells...)
+		}
+	}
+	return t
+}
+
+// Append adds a row to the table.
+func (t *Table) Append(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+// AppendRows adds rows to the table.
+func (t *Table) AppendRows(rows [][]string) {
+	t.rows = append(t.rows, rows...)
+}
+
+// Len returns the number of rows of the table.
+func (t *Table) Len() int {
+	return len(t.rows)
+}
+
+// Print writes the table, nothing if it has no rows.
+func (t *Table) Print(w io.Writer) error {
+	if len(t.rows) == 0 {
+		return nil
+	}
+	switch {
+	case IsDelimited(t.opts.Output):
+		cw := csv.NewWriter(w)
+		if t.opts.Output == TSV {
+			cw.Comma = '\t'
+		}
+		if !t.opts.NoHeaders {
+			if err := cw.Write(t.headers); err != nil {
+				return err
+			}
+		}
+		if err := cw.WriteAll(t.rows); err != nil {
+			return err
+		}
+		return cw.Error()
+	case t.opts.Output == Markdown:
+		return t.printMarkdown(w)
+	case t.opts.Output == HTML:
+		return t.printHTML(w)
+	case t.opts.Bordered:
+		table := tablewriter.NewWriter(w)
+		if !t.opts.NoHeaders {
+			table.SetHeader(t.headers)
+		}
+		table.AppendBulk(t.rows)
+		table.Render()
+		return nil
+	}
+	tw := tabwriter.NewWriter(w, t.opts.MinWidth, 0, 3, ' ', 0)
+	if !t.opts.NoHeaders {
+		fmt.Fprintln(tw, t.opts.Indent+strings.Join(t.headers, "\t"))
+	}
+	for _, row := range t.rows {
+		fmt.Fprintln(tw, t.opts.Indent+strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// markdownEscaper escapes the characters ending a cell or read as markdown, and breaks lines
+// with <br> as a table row cannot span several lines.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "|", `\|`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`,
+	"&", "&amp;", "<", "&lt;", ">", "&gt;", "\r\n", "<br>", "\n", "<br>",
+)
+
+// printMarkdown prints the table as a GitHub flavored markdown table.
+func (t *Table) printMarkdown(w io.Writer) error {
+	var b strings.Builder
+	writeRow := func(cells []string) {
+		b.WriteString("|")
+		for _, cell := range cells {
+			b.WriteString(" " + markdownEscaper.Replace(cell) + " |")
+		}
+		b.WriteString("\n")
+	}
+	writeRow(t.headers)
+	b.WriteString(strings.Repeat("| --- ", len(t.headers)) + "|\n")
+	for _, row := range t.rows {
+		writeRow(row)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// printHTML prints the table as an HTML table.
+func (t *Table) printHTML(w io.Writer) error {
+	var b strings.Builder
+	writeRow := func(tag string, cells []string) {
+		b.WriteString("<tr>")
+		for _, cell := range cells {
+			cell = strings.ReplaceAll(html.EscapeString(cell), "\n", "<br>")
+			b.WriteString("<" + tag + ">" + cell + "</" + tag + ">")
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("<table>\n")
+	if !t.opts.NoHeaders {
+		b.WriteString("<thead>\n")
+		writeRow("th", t.headers)
+		b.WriteString("</thead>\n")
+	}
+	b.WriteString("<tbody>\n")
+	for _, row := range t.rows {
+		writeRow("td", row)
+	}
+	b.WriteString("</tbody>\n</table>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+
+package tableprinter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPrint(t *testing.T) {
+	rows := [][]string{{"ns1", "web", `say "hi", then leave`}, {"ns2", "db", ""}}
+	tests := []struct {
+		name string
+		opts Options
+		want string
+	}{
+		{
+			name: "aligned",
+			opts: Options{},
+			want: "NAMESPACE   NAME   MESSAGE\nns1         web    say \"hi\", then leave\nns2         db     \n",
+		},
+		{
+			name: "indented",
+			opts: Options{Indent: "  ", MinWidth: 14, NoHeaders: true},
+			want: "  ns1         web           say \"hi\", then leave\n  ns2         db            \n",
+		},
+		{
+			name: "csv",
+			opts: Options{Output: CSV},
+			want: "NAMESPACE,NAME,MESSAGE\nns1,web,\"say \"\"hi\"\", then leave\"\nns2,db,\n",
+		},
+		{
+			name: "tsv",
+			opts: Options{Output: TSV, NoHeaders: true},
+			want: "ns1\tweb\t\"say \"\"hi\"\", then leave\"\nns2\tdb\t\n",
+		},
+		{
+			name: "markdown",
+			opts: Options{Output: Markdown},
+			want: "| NAMESPACE | NAME | MESSAGE |\n| --- | --- | --- |\n| ns1 | web | say \"hi\", then leave |\n| ns2 | db |  |\n",
+		},
+		{
+			name: "html",
+			opts: Options{Output: HTML, NoHeaders: true},
+			want: "<table>\n<tbody>\n<tr><td>ns1</td><td>web</td><td>say &#34;hi&#34;, then leave</td></tr>\n<tr><td>ns2</td><td>db</td><td></td></tr>\n</tbody>\n</table>\n",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			table := New(tc.opts, "NAMESPACE", "NAME", "MESSAGE")
+			table.AppendRows(rows)
+			var out bytes.Buffer
+			if err := table.Print(&out); err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != tc.want {
+				t.Errorf("expected:\n%q\ngot:\n%q", tc.want, out.String())
+			}
+		})
+	}
+}
+
+func TestPrint_Escaping(t *testing.T) {
+	cell := "a|b *c* <d> & e_f\nline"
+	tests := []struct {
+		output string
+		want   string
+	}{
+		{output: Markdown, want: "| A\\|B |\n| --- |\n| a\\|b \\*c\\* &lt;d&gt; &amp; e\\_f<br>line |\n"},
+		{output: HTML, want: "<table>\n<thead>\n<tr><th>A|B</th></tr>\n</thead>\n<tbody>\n<tr><td>a|b *c* &lt;d&gt; &amp; e_f<br>line</td></tr>\n</tbody>\n</table>\n"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.output, func(t *testing.T) {
+			table := New(Options{Output: tc.output}, "A|B")
+			table.Append(cell)
+			var out bytes.Buffer
+			if err := table.Print(&out); err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != tc.want {
+				t.Errorf("expected:\n%q\ngot:\n%q", tc.want, out.String())
+			}
+		})
+	}
+}
+
+func TestPrint_Bordered(t *testing.T) {
+	table := New(Options{Bordered: true}, "ENDPOINT", "HEALTH")
+	table.Append("https://10.0.0.1:2379", "true")
+	var out bytes.Buffer
+	if err := table.Print(&out); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"+-", "ENDPOINT", "| https://10.0.0.1:2379 | true"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected %q in:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestPrint_Empty(t *testing.T) {
+	for _, output := range []string{"", CSV, Markdown, HTML} {
+		var out bytes.Buffer
+		if err := New(Options{Output: output}, "NAME").Print(&out); err != nil {
+			t.Fatal(err)
+		}
+		if out.Len() != 0 {
+			t.Errorf("expected no output for %q, got %q", output, out.String())
+		}
+	}
+}
+
+func TestFromMetaTable(t *testing.T) {
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name"},
+			{Name: "Node", Priority: 1},
+			{Name: "Age"},
+		},
+		Rows: []metav1.TableRow{
+			{Cells: []interface{}{"p1", "n1", "3d"}},
+			{Cells: []interface{}{"p2", nil, int64(5)}},
+		},
+	}
+	tests := []struct {
+		name string
+		wide bool
+		want string
+	}{
+		{name: "default", want: "NAME,AGE\np1,3d\np2,5\n"},
+		{name: "wide", wide: true, want: "NAME,NODE,AGE\np1,n1,3d\np2,,5\n"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := FromMetaTable(Options{Output: CSV}, table, tc.wide).Print(&out); err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, out.String())
+			}
+		})
+	}
+}
+
+func TestConcat(t *testing.T) {
+	pods := New(Options{}, "NAME", "READY", "STATUS")
+	pods.Append("p1", "1/1", "Running")
+	deployments := New(Options{}, "NAME", "READY", "UP-TO-DATE")
+	deployments.Append("d1", "2/2", "2")
+	deployments.Append("d2", "0/1", "1")
+	tests := []struct {
+		name string
+		opts Options
+		want string
+	}{
+		{name: "csv", opts: Options{Output: CSV}, want: "KIND,NAME,READY,STATUS,UP-TO-DATE\nPod,p1,1/1,Running,\nDeployment.apps,d1,2/2,,2\nDeployment.apps,d2,0/1,,1\n"},
+		{name: "tsv without headers", opts: Options{Output: TSV, NoHeaders: true}, want: "Pod\tp1\t1/1\tRunning\t\nDeployment.apps\td1\t2/2\t\t2\nDeployment.apps\td2\t0/1\t\t1\n"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := Concat(tc.opts, "KIND", []string{"Pod", "Deployment.apps"}, []*Table{pods, deployments}).Print(&out); err != nil {
+				t.Fatal(err)
+			}
+			if out.String() != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, out.String())
+			}
+		})
+	}
+}
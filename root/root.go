@@ -24,12 +24,14 @@ import (
 
 	"github.com/gmeghnag/omc/cmd"
 	"github.com/gmeghnag/omc/cmd/admin"
+	"github.com/gmeghnag/omc/cmd/apiresources"
 	"github.com/gmeghnag/omc/cmd/ceph"
 	"github.com/gmeghnag/omc/cmd/certs"
 	"github.com/gmeghnag/omc/cmd/config"
 	"github.com/gmeghnag/omc/cmd/describe"
 	"github.com/gmeghnag/omc/cmd/etcd"
 	"github.com/gmeghnag/omc/cmd/events"
+	"github.com/gmeghnag/omc/cmd/explain"
 	"github.com/gmeghnag/omc/cmd/get"
 	getsource "github.com/gmeghnag/omc/cmd/get-source"
 	"github.com/gmeghnag/omc/cmd/haproxy"
@@ -40,6 +42,10 @@ import (
 	nodelogs "github.com/gmeghnag/omc/cmd/node-logs"
 	"github.com/gmeghnag/omc/cmd/ovn"
 	"github.com/gmeghnag/omc/cmd/prometheus"
+	"github.com/gmeghnag/omc/cmd/query"
+	"github.com/gmeghnag/omc/cmd/serve"
+	"github.com/gmeghnag/omc/cmd/timeline"
+	"github.com/gmeghnag/omc/cmd/ui"
 	"github.com/gmeghnag/omc/cmd/upgrade"
 	"github.com/gmeghnag/omc/cmd/use"
 	"github.com/gmeghnag/omc/types"
@@ -96,6 +102,8 @@ func init() {
 	// when this action is called directly.
 	RootCmd.AddCommand(
 		admin.Admin,
+		apiresources.APIResourcesCmd,
+		apiresources.APIVersionsCmd,
 		ceph.Ceph,
 		ceph.CephVolume,
 		ceph.Rados,
@@ -116,10 +124,15 @@ func init() {
 		describe.DescribeCmd,
 		etcd.Etcd,
 		logs.Logs,
+		timeline.Timeline,
 		machineconfig.MachineConfig,
 		ovn.OvnCmd,
 		prometheus.PrometheusCmd,
+		query.QueryCmd,
+		serve.ServeCmd,
+		ui.UICmd,
 		events.EventsCmd,
+		explain.ExplainCmd,
 		upgrade.Upgrade,
 		insights.InsightsCmd,
 	)
@@ -202,4 +215,5 @@ func loadOmcConfigs() {
 	vars.UseLocalCRDs = omcConfigJson.UseLocalCRDs
 	vars.DiffCmd = omcConfigJson.DiffCmd
 	vars.DefaultProject = omcConfigJson.DefaultProject
+	vars.Parallelism = omcConfigJson.Parallelism
 }
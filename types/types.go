@@ -31,6 +31,7 @@ type Config struct {
 	UseLocalCRDs   bool      `json:"use_local_crds,omitempty"`
 	DiffCmd        string    `json:"diff_command,omitempty"`
 	DefaultProject string    `json:"default_project,omitempty"`
+	Parallelism    int       `json:"parallelism,omitempty"`
 }
 
 type DescribeClient struct {
@@ -5,13 +5,13 @@ import (
 
 	"github.com/gmeghnag/omc/types"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/kubernetes/pkg/printers"
 )
 
 var Tail int64
-var CfgFile, Namespace, MustGatherRootPath, OutputStringVar, LabelSelectorStringVar, Id, Container, OMCVersionHash, OMCVersionTag, DiffCmd, CurrentKind, LastKind, DefaultProject, ForResource string
+var Parallelism int
+var CfgFile, Namespace, MustGatherRootPath, OutputStringVar, LabelSelectorStringVar, FieldSelectorStringVar, Id, Container, OMCVersionHash, OMCVersionTag, DiffCmd, DefaultProject, ForResource string
 var AllNamespaceBoolVar, ShowLabelsBoolVar, Previous, Rotated, AllContainers, UseLocalCRDs, SingleResource, Wide, ShowKind, ShowNamespace, ShowManagedFields, NoHeaders, InsecureLogs bool
 
 var EventTypes []string
@@ -29,6 +29,9 @@ var JsonPathList types.JsonPathList
 
 var Output bytes.Buffer
 
-var Table metav1.Table
-
 var SortBy string
+var SortReverse bool
+
+var LabelColumns []string
+
+var Template string